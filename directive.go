@@ -0,0 +1,191 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/spaceweasel/enumer/gen"
+)
+
+// directivePrefix starts a comment on a type declaration that sets flags
+// for the type, e.g. //enumer:json,sql,trimprefix=Status
+const directivePrefix = "//enumer:"
+
+// nonDirectiveFlags can only be given on the command line, as they control
+// loading the packages and writing the output
+var nonDirectiveFlags = map[string]bool{
+	"type": true, "output": true, "filetemplate": true, "tags": true, "pkg": true, "template": true,
+	"splitfiles": true, "manifest": true, "check": true, "recursive": true, "version": true,
+}
+
+// groupOptions returns the options for types generated together: the
+// command line flags merged with the //enumer: directives of the types,
+// with the command line winning on conflict. Types sharing a file must
+// have the same directives
+func groupOptions(pkg *packages.Package, group []string, opts gen.Options) (gen.Options, error) {
+	var args []string
+	var pos token.Position
+	for i, typeName := range group {
+		typeArgs, typePos, err := typeDirective(pkg, typeName)
+		if err != nil {
+			return gen.Options{}, err
+		}
+		if i == 0 {
+			args, pos = typeArgs, typePos
+			continue
+		}
+		if !slices.Equal(typeArgs, args) {
+			return gen.Options{}, fmt.Errorf("%s and %s have different %s directives, so can't share a file; use -splitfiles", group[0], typeName, directivePrefix)
+		}
+	}
+	if len(args) == 0 {
+		return opts, nil
+	}
+
+	opts, err := directiveOptions(args)
+	if err != nil {
+		return gen.Options{}, fmt.Errorf("%s: %w", relativePos(pos.String()), err)
+	}
+	return opts, nil
+}
+
+// typeDirective returns the flags set by the //enumer: directives in the doc
+// comment of the type's declaration, and the position of the first one
+func typeDirective(pkg *packages.Package, typeName string) ([]string, token.Position, error) {
+	for _, file := range pkg.Syntax {
+		for _, decl := range file.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if ts.Name.Name != typeName {
+					continue
+				}
+				doc := ts.Doc
+				if doc == nil && !gd.Lparen.IsValid() {
+					doc = gd.Doc
+				}
+				return parseDirectives(pkg.Fset, doc)
+			}
+		}
+	}
+	return nil, token.Position{}, nil
+}
+
+// parseDirectives splits the //enumer: directives of a comment into flags
+func parseDirectives(fset *token.FileSet, doc *ast.CommentGroup) ([]string, token.Position, error) {
+	if doc == nil {
+		return nil, token.Position{}, nil
+	}
+	var args []string
+	var pos token.Position
+	for _, c := range doc.List {
+		text, ok := strings.CutPrefix(c.Text, directivePrefix)
+		if !ok {
+			continue
+		}
+		if len(args) == 0 {
+			pos = fset.Position(c.Pos())
+		}
+		fields, err := splitDirective(text)
+		if err != nil {
+			return nil, token.Position{}, fmt.Errorf("%s: %w", relativePos(fset.Position(c.Pos()).String()), err)
+		}
+		args = append(args, fields...)
+	}
+	return args, pos, nil
+}
+
+// splitDirective splits the text of a directive into flags, separated by
+// commas or spaces. Values containing either can be quoted, e.g.
+// trimprefix="Status,Kind"
+func splitDirective(s string) ([]string, error) {
+	var fields []string
+	for {
+		s = strings.TrimLeft(s, ", \t")
+		if s == "" {
+			return fields, nil
+		}
+		end := strings.IndexAny(s, ", \t\"")
+		if end < 0 {
+			end = len(s)
+		}
+		field := s[:end]
+		s = s[end:]
+		if strings.HasPrefix(s, `"`) {
+			quoted, err := strconv.QuotedPrefix(s)
+			if err != nil {
+				return nil, fmt.Errorf("unterminated quoted value %s", s)
+			}
+			value, _ := strconv.Unquote(quoted)
+			field += value
+			s = s[len(quoted):]
+		}
+		fields = append(fields, field)
+	}
+}
+
+// directiveOptions returns the options given by the command line flags and
+// the flags set by a directive, skipping those set on the command line.
+// The flags are reset afterwards, so they can be applied for each type
+func directiveOptions(args []string) (gen.Options, error) {
+	set := make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+
+	var changed []*flag.Flag
+	defer func() {
+		for _, f := range changed {
+			resetFlag(f)
+		}
+	}()
+	for _, arg := range args {
+		name, value, hasValue := strings.Cut(strings.TrimPrefix(arg, "-"), "=")
+		f := flag.Lookup(name)
+		switch {
+		case f == nil:
+			return gen.Options{}, fmt.Errorf("unknown flag %q in %s directive", name, directivePrefix)
+		case nonDirectiveFlags[name]:
+			return gen.Options{}, fmt.Errorf("-%s can only be given on the command line", name)
+		case set[name]:
+			// The command line wins
+			continue
+		}
+		if !hasValue {
+			if bf, ok := f.Value.(interface{ IsBoolFlag() bool }); !ok || !bf.IsBoolFlag() {
+				return gen.Options{}, fmt.Errorf("-%s needs a value, e.g. %s=...", name, name)
+			}
+			value = "true"
+		}
+		changed = append(changed, f)
+		if err := f.Value.Set(value); err != nil {
+			return gen.Options{}, fmt.Errorf("invalid value %q for -%s: %v", value, name, err)
+		}
+	}
+
+	opts := newOptions()
+	if err := opts.Validate(); err != nil {
+		return gen.Options{}, fmt.Errorf("invalid options: %w", err)
+	}
+	return opts, nil
+}
+
+// resetFlag sets a flag that wasn't given on the command line back to its
+// default
+func resetFlag(f *flag.Flag) {
+	if m, ok := f.Value.(*modeFlag); ok {
+		m.value = ""
+		return
+	}
+	f.Value.Set(f.DefValue)
+}
@@ -2,6 +2,10 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -11,6 +15,574 @@ import (
 	qt "github.com/frankban/quicktest"
 )
 
+func TestEnumerWithoutGofmt(t *testing.T) {
+	c := qt.New(t)
+
+	enumerBin := buildEnumer(c)
+	tmpDir := setupModule(c, filepath.Join("testdata", "simple_iota"))
+
+	// Put only the go tool on PATH, so gofmt can't be found
+	goBin, err := exec.LookPath("go")
+	c.Assert(err, qt.IsNil)
+	binDir := c.TempDir()
+	c.Assert(os.Symlink(goBin, filepath.Join(binDir, "go")), qt.IsNil)
+
+	cmd := exec.Command(enumerBin, "-type=Status", "-json")
+	cmd.Dir = tmpDir
+	cmd.Env = append(os.Environ(), "PATH="+binDir)
+	output, err := cmd.CombinedOutput()
+	c.Assert(err, qt.IsNil, qt.Commentf("enumer output: %s", output))
+
+	// The generated file should already be gofmt formatted
+	data, err := os.ReadFile(filepath.Join(tmpDir, "status_enumer.go"))
+	c.Assert(err, qt.IsNil)
+	formatted, err := format.Source(data)
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(data), qt.Equals, string(formatted))
+}
+
+func TestEnumerStdout(t *testing.T) {
+	c := qt.New(t)
+
+	enumerBin := buildEnumer(c)
+	tmpDir := setupModule(c, filepath.Join("testdata", "simple_iota"))
+
+	before, err := os.ReadDir(tmpDir)
+	c.Assert(err, qt.IsNil)
+
+	cmd := exec.Command(enumerBin, "-type=Status", "-output=-")
+	cmd.Dir = tmpDir
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err = cmd.Run()
+	c.Assert(err, qt.IsNil, qt.Commentf("stderr: %s", stderr.String()))
+
+	c.Assert(stdout.String(), qt.Contains, "\npackage testpkg\n")
+	c.Assert(stdout.String(), qt.Contains, "// Command: enumer -type=Status -output=-\n")
+
+	// No file should have been written
+	after, err := os.ReadDir(tmpDir)
+	c.Assert(err, qt.IsNil)
+	c.Assert(after, qt.HasLen, len(before))
+}
+
+func TestEnumerUsage(t *testing.T) {
+	c := qt.New(t)
+
+	enumerBin := buildEnumer(c)
+
+	// Without -type, enumer prints its usage and fails
+	cmd := exec.Command(enumerBin)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	c.Assert(err, qt.ErrorMatches, "exit status 2")
+
+	out := stderr.String()
+	c.Assert(out, qt.Contains, "Usage: enumer -type=T[,T...] [flags] [package]\n")
+	c.Assert(out, qt.Contains, "\nExamples:\n  enumer -type=Status\n")
+	for _, section := range []string{"Input", "Output", "String representation", "Parsing", "Encoding", "Other methods"} {
+		c.Assert(out, qt.Contains, "\n"+section+":\n")
+	}
+	c.Assert(out, qt.Contains, "\n  -type list\n")
+	c.Assert(out, qt.Contains, "\n  -json[=string|number|lenient|preserve]\n")
+	c.Assert(out, qt.Contains, "\n  -bitmask[=named|bits]\n")
+	c.Assert(out, qt.Contains, "\n  -iter\n")
+	c.Assert(out, qt.Contains, `(default "map")`)
+	c.Assert(out, qt.Not(qt.Contains), "\nOther:\n")
+}
+
+func TestPrintUsageListsEveryFlag(t *testing.T) {
+	c := qt.New(t)
+
+	var buf bytes.Buffer
+	printUsage(&buf)
+	flag.VisitAll(func(f *flag.Flag) {
+		c.Check(buf.String(), qt.Matches, `(?s).*\n  -`+f.Name+`[ \[\n].*`)
+	})
+}
+
+func TestSplitDirective(t *testing.T) {
+	c := qt.New(t)
+
+	fields, err := splitDirective(`json,sql  trimprefix="Status,Kind" -transform=snake,`)
+	c.Assert(err, qt.IsNil)
+	c.Assert(fields, qt.DeepEquals, []string{"json", "sql", "trimprefix=Status,Kind", "-transform=snake"})
+
+	_, err = splitDirective(`trimprefix="Status`)
+	c.Assert(err, qt.ErrorMatches, `unterminated quoted value "Status`)
+}
+
+func TestDirectiveOptions(t *testing.T) {
+	c := qt.New(t)
+
+	opts, err := directiveOptions([]string{"json=number", "sql", "trimprefix=Status"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(opts.JSON, qt.Equals, "number")
+	c.Assert(opts.SQL, qt.Equals, "text")
+	c.Assert(opts.TrimPrefix, qt.Equals, "Status")
+
+	// The flags are reset for the next type
+	c.Assert(newOptions().JSON, qt.Equals, "")
+	c.Assert(newOptions().TrimPrefix, qt.Equals, "")
+
+	_, err = directiveOptions([]string{"jsn"})
+	c.Assert(err, qt.ErrorMatches, `unknown flag "jsn" in //enumer: directive`)
+	_, err = directiveOptions([]string{"output=x.go"})
+	c.Assert(err, qt.ErrorMatches, `-output can only be given on the command line`)
+	_, err = directiveOptions([]string{"trimprefix"})
+	c.Assert(err, qt.ErrorMatches, `-trimprefix needs a value, e.g. trimprefix=...`)
+	_, err = directiveOptions([]string{"json=yes"})
+	c.Assert(err, qt.ErrorMatches, `invalid value "yes" for -json: unknown mode .*`)
+}
+
+func TestEnumerRegenerate(t *testing.T) {
+	c := qt.New(t)
+
+	enumerBin := buildEnumer(c)
+	tmpDir := setupModule(c, filepath.Join("testdata", "simple_iota"))
+
+	// The second run loads a package that includes the first run's output,
+	// which imports fmt
+	for i := 0; i < 2; i++ {
+		cmd := exec.Command(enumerBin, "-type=Status")
+		cmd.Dir = tmpDir
+		output, err := cmd.CombinedOutput()
+		c.Assert(err, qt.IsNil, qt.Commentf("run %d, enumer output: %s", i+1, output))
+	}
+}
+
+func TestEnumerCommand(t *testing.T) {
+	c := qt.New(t)
+
+	enumerBin := buildEnumer(c)
+	tmpDir := setupModule(c, filepath.Join("testdata", "simple_iota"))
+
+	cmd := exec.Command(enumerBin, "-yaml=false", "-transform=snake", "-json=number", "-type=Status",
+		"-addprefix=my status ", "-caseinsensitive", "-buildtags=!legacy && go1.18", "-yamlversion=3", "-output=-", ".")
+	cmd.Dir = tmpDir
+	output, err := cmd.Output()
+	c.Assert(err, qt.IsNil)
+
+	// Flags are in name order, skipping those left at their defaults
+	const command = `enumer -type=Status -addprefix="my status " -buildtags="!legacy && go1.18" -caseinsensitive -json=number -output=- -transform=snake .`
+	c.Assert(string(output), qt.Contains, "// Command: "+command+"\n")
+
+	// Running the command again, as it would be copied from the header,
+	// produces the same output
+	cmd = exec.Command("sh", "-c", enumerBin+strings.TrimPrefix(command, "enumer"))
+	cmd.Dir = tmpDir
+	regenerated, err := cmd.Output()
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(regenerated), qt.Equals, string(output))
+}
+
+func TestEnumerVersion(t *testing.T) {
+	c := qt.New(t)
+
+	enumerBin := buildEnumer(c)
+	output, err := exec.Command(enumerBin, "-version").Output()
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(output), qt.Matches, "enumer \\S+\n")
+
+	// Without one set when building, the header has no version, so
+	// different builds generate the same files
+	tmpDir := setupModule(c, filepath.Join("testdata", "simple_iota"))
+	cmd := exec.Command(enumerBin, "-type=Status", "-output=-")
+	cmd.Dir = tmpDir
+	output, err = cmd.Output()
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(output), qt.Not(qt.Contains), "// Version:")
+
+	// A version set when building is printed and recorded in the header
+	tmpBin := filepath.Join(c.TempDir(), "enumer")
+	cmd = exec.Command("go", "build", "-ldflags=-X main.version=v1.2.3-test", "-o", tmpBin, ".")
+	buildOutput, err := cmd.CombinedOutput()
+	c.Assert(err, qt.IsNil, qt.Commentf("build output: %s", buildOutput))
+
+	output, err = exec.Command(tmpBin, "-version").Output()
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(output), qt.Equals, "enumer v1.2.3-test\n")
+
+	cmd = exec.Command(tmpBin, "-type=Status", "-output=-")
+	cmd.Dir = tmpDir
+	output, err = cmd.Output()
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(output), qt.Contains, "// Command: enumer -type=Status -output=-\n// Version: v1.2.3-test\n")
+}
+
+func TestEnumerRepeatedType(t *testing.T) {
+	c := qt.New(t)
+
+	enumerBin := buildEnumer(c)
+	tmpDir := setupModule(c, filepath.Join("testdata", "dense"))
+
+	// Repeated and comma-separated names are combined, sorted and deduplicated
+	cmd := exec.Command(enumerBin, "-type=Weekday", "-type=Level, Weekday", "-linecomment=optional", "-output=-")
+	cmd.Dir = tmpDir
+	output, err := cmd.Output()
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(output), qt.Contains, "// Command: enumer -type=Level,Weekday -linecomment=optional -output=-\n")
+	c.Assert(string(output), qt.Contains, "func (i Level) String() string {")
+	c.Assert(string(output), qt.Contains, "func (i Weekday) String() string {")
+}
+
+func TestEnumerCheck(t *testing.T) {
+	c := qt.New(t)
+
+	enumerBin := buildEnumer(c)
+	tmpDir := setupModule(c, filepath.Join("testdata", "simple_iota"))
+	outputFile := filepath.Join(tmpDir, "status_enumer.go")
+
+	runCheck := func() (string, error) {
+		cmd := exec.Command(enumerBin, "-type=Status", "-json", "-check")
+		cmd.Dir = tmpDir
+		output, err := cmd.CombinedOutput()
+		return string(output), err
+	}
+
+	// A missing file is out of date
+	output, err := runCheck()
+	c.Assert(err, qt.IsNotNil)
+	c.Assert(output, qt.Contains, "status_enumer.go is out of date")
+	_, err = os.Stat(outputFile)
+	c.Assert(os.IsNotExist(err), qt.IsTrue)
+
+	// A freshly generated file passes
+	cmd := exec.Command(enumerBin, "-type=Status", "-json")
+	cmd.Dir = tmpDir
+	c.Assert(cmd.Run(), qt.IsNil)
+	output, err = runCheck()
+	c.Assert(err, qt.IsNil, qt.Commentf("check output: %s", output))
+
+	// A modified file fails, and is left as it is
+	data, err := os.ReadFile(outputFile)
+	c.Assert(err, qt.IsNil)
+	modified := bytes.Replace(data, []byte(`"Running"`), []byte(`"Walking"`), 1)
+	c.Assert(os.WriteFile(outputFile, modified, 0644), qt.IsNil)
+
+	output, err = runCheck()
+	c.Assert(err, qt.IsNotNil)
+	c.Assert(output, qt.Matches, `(?s).*status_enumer.go is out of date: line \d+ is ".*Walking.*", want ".*Running.*".*`)
+	after, err := os.ReadFile(outputFile)
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(after), qt.Equals, string(modified))
+}
+
+func TestEnumerOutputDir(t *testing.T) {
+	c := qt.New(t)
+
+	enumerBin := buildEnumer(c)
+	tmpDir := setupModule(c, filepath.Join("testdata", "simple_iota"))
+
+	// A path ending in a separator is created
+	cmd := exec.Command(enumerBin, "-type=Status", "-output=generated/")
+	cmd.Dir = tmpDir
+	output, err := cmd.CombinedOutput()
+	c.Assert(err, qt.IsNil, qt.Commentf("enumer output: %s", output))
+	_, err = os.Stat(filepath.Join(tmpDir, "generated", "status_enumer.go"))
+	c.Assert(err, qt.IsNil)
+
+	// An existing directory is used without the separator
+	c.Assert(os.Mkdir(filepath.Join(tmpDir, "existing"), 0755), qt.IsNil)
+	cmd = exec.Command(enumerBin, "-type=Status", "-output=existing")
+	cmd.Dir = tmpDir
+	output, err = cmd.CombinedOutput()
+	c.Assert(err, qt.IsNil, qt.Commentf("enumer output: %s", output))
+	_, err = os.Stat(filepath.Join(tmpDir, "existing", "status_enumer.go"))
+	c.Assert(err, qt.IsNil)
+}
+
+func TestEnumerDirectiveOutputName(t *testing.T) {
+	c := qt.New(t)
+
+	enumerBin := buildEnumer(c)
+	tmpDir := setupModule(c, filepath.Join("testdata", "simple_iota"))
+
+	// Types made bitmasks by their directives get the bitmask file name
+	const flags = `package testpkg
+
+//enumer:bitmask
+type Read int
+
+const ReadAll Read = 1
+
+//enumer:bitmask
+type Write int
+
+const WriteAll Write = 1
+`
+	c.Assert(os.WriteFile(filepath.Join(tmpDir, "flags.go"), []byte(flags), 0644), qt.IsNil)
+
+	cmd := exec.Command(enumerBin, "-type=Read,Write")
+	cmd.Dir = tmpDir
+	output, err := cmd.CombinedOutput()
+	c.Assert(err, qt.IsNil, qt.Commentf("enumer output: %s", output))
+	_, err = os.Stat(filepath.Join(tmpDir, "flags_gen.go"))
+	c.Assert(err, qt.IsNil)
+	_, err = os.Stat(filepath.Join(tmpDir, "enums_gen.go"))
+	c.Assert(os.IsNotExist(err), qt.IsTrue)
+}
+
+func TestEnumerFileTemplate(t *testing.T) {
+	c := qt.New(t)
+
+	enumerBin := buildEnumer(c)
+	tmpDir := setupModule(c, filepath.Join("testdata", "dense"))
+
+	// Each type gets a file named by the pattern
+	cmd := exec.Command(enumerBin, "-type=Level,Weekday", "-linecomment=optional", "-splitfiles", "-filetemplate=enum_{{.Type}}.gen.go")
+	cmd.Dir = tmpDir
+	output, err := cmd.CombinedOutput()
+	c.Assert(err, qt.IsNil, qt.Commentf("enumer output: %s", output))
+	for _, name := range []string{"enum_level.gen.go", "enum_weekday.gen.go"} {
+		_, err = os.Stat(filepath.Join(tmpDir, name))
+		c.Assert(err, qt.IsNil)
+	}
+	_, err = os.Stat(filepath.Join(tmpDir, "level_enumer.go"))
+	c.Assert(os.IsNotExist(err), qt.IsTrue)
+
+	// A combined file joins the type names
+	cmd = exec.Command(enumerBin, "-type=Level,Weekday", "-linecomment=optional", "-filetemplate={{.Type}}_gen.go", "-output=combined/")
+	cmd.Dir = tmpDir
+	output, err = cmd.CombinedOutput()
+	c.Assert(err, qt.IsNil, qt.Commentf("enumer output: %s", output))
+	_, err = os.Stat(filepath.Join(tmpDir, "combined", "level_weekday_gen.go"))
+	c.Assert(err, qt.IsNil)
+
+	// The pattern must give the name of a Go file
+	for pattern, msg := range map[string]string{
+		"enum_{{.Type}}.txt":  `doesn't give a .go file name`,
+		"gen/{{.Type}}.go":    `gives a path rather than a file name; use -output for the directory`,
+		"{{.Type}}_test.go":   `gives a test file name`,
+		"{{.Name}}_enumer.go": `can't evaluate field Name`,
+		"{{.Type}_enumer.go":  `bad character`,
+	} {
+		cmd = exec.Command(enumerBin, "-type=Level", "-filetemplate="+pattern)
+		cmd.Dir = tmpDir
+		output, err = cmd.CombinedOutput()
+		c.Assert(err, qt.IsNotNil)
+		c.Assert(string(output), qt.Contains, "Invalid -filetemplate: ")
+		c.Assert(string(output), qt.Contains, msg)
+	}
+}
+
+func TestEnumerManifest(t *testing.T) {
+	c := qt.New(t)
+
+	enumerBin := buildEnumer(c)
+	tmpDir := setupModule(c, filepath.Join("testdata", "dense"))
+
+	cmd := exec.Command(enumerBin, "-type=Weekday,Level", "-linecomment=optional", "-splitfiles", "-manifest=docs/enums.json")
+	cmd.Dir = tmpDir
+	output, err := cmd.CombinedOutput()
+	c.Assert(err, qt.IsNil, qt.Commentf("enumer output: %s", output))
+
+	data, err := os.ReadFile(filepath.Join(tmpDir, "docs", "enums.json"))
+	c.Assert(err, qt.IsNil)
+	var manifest struct {
+		Enums []struct {
+			Name       string
+			Underlying string
+			Elements   []struct {
+				Name   string
+				Value  int
+				String string
+				Alias  bool
+			}
+		}
+	}
+	c.Assert(json.Unmarshal(data, &manifest), qt.IsNil)
+
+	// Both types are described, in name order, with every constant
+	c.Assert(manifest.Enums, qt.HasLen, 2)
+	level, weekday := manifest.Enums[0], manifest.Enums[1]
+	c.Assert(level.Name, qt.Equals, "Level")
+	c.Assert(level.Underlying, qt.Equals, "uint8")
+	c.Assert(level.Elements, qt.HasLen, 3)
+	c.Assert(level.Elements[2].Name, qt.Equals, "High")
+	c.Assert(level.Elements[2].Value, qt.Equals, 2)
+	c.Assert(weekday.Name, qt.Equals, "Weekday")
+	c.Assert(weekday.Elements, qt.HasLen, 8)
+	c.Assert(weekday.Elements[1].Name, qt.Equals, "FirstDay")
+	c.Assert(weekday.Elements[1].Alias, qt.IsTrue)
+	c.Assert(weekday.Elements[3].Name, qt.Equals, "Wednesday")
+	c.Assert(weekday.Elements[3].Value, qt.Equals, 3)
+	c.Assert(weekday.Elements[3].String, qt.Equals, "Wed")
+
+	// The manifest is checked like the generated files
+	cmd = exec.Command(enumerBin, "-type=Weekday,Level", "-linecomment=optional", "-splitfiles", "-manifest=docs/enums.json", "-check")
+	cmd.Dir = tmpDir
+	output, err = cmd.CombinedOutput()
+	c.Assert(err, qt.IsNil, qt.Commentf("check output: %s", output))
+}
+
+func TestEnumerIgnoredFilesWarning(t *testing.T) {
+	c := qt.New(t)
+
+	enumerBin := buildEnumer(c)
+	tmpDir := setupModule(c, filepath.Join("testdata", "tags"))
+
+	// Without the tag, the guarded constant is missing and enumer says why
+	cmd := exec.Command(enumerBin, "-type=Status", "-output=-")
+	cmd.Dir = tmpDir
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	c.Assert(cmd.Run(), qt.IsNil, qt.Commentf("stderr: %s", stderr.String()))
+	c.Assert(stdout.String(), qt.Not(qt.Contains), "Archived")
+	c.Assert(stderr.String(), qt.Matches, `(?s).*Warning: .*enterprise.go declares Status constants but is excluded by build constraints.*`)
+
+	cmd = exec.Command(enumerBin, "-type=Status", "-tags=enterprise", "-output=-")
+	cmd.Dir = tmpDir
+	output, err := cmd.CombinedOutput()
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(output), qt.Not(qt.Contains), "Warning")
+	c.Assert(string(output), qt.Contains, `"Archived"`)
+}
+
+func TestEnumerPackageName(t *testing.T) {
+	c := qt.New(t)
+
+	enumerBin := buildEnumer(c)
+	tmpDir := setupModule(c, filepath.Join("testdata", "simple_iota"))
+
+	// Generate helpers for a vendored copy of the declarations in a sibling package
+	vendorDir := filepath.Join(tmpDir, "vendored")
+	c.Assert(os.Mkdir(vendorDir, 0755), qt.IsNil)
+	types, err := os.ReadFile(filepath.Join(tmpDir, "types.go"))
+	c.Assert(err, qt.IsNil)
+	types = bytes.Replace(types, []byte("package testpkg"), []byte("package vendored"), 1)
+	c.Assert(os.WriteFile(filepath.Join(vendorDir, "types.go"), types, 0644), qt.IsNil)
+
+	cmd := exec.Command(enumerBin, "-type=Status", "-pkg=vendored", "-output=vendored/status_enumer.go")
+	cmd.Dir = tmpDir
+	output, err := cmd.CombinedOutput()
+	c.Assert(err, qt.IsNil, qt.Commentf("enumer output: %s", output))
+
+	data, err := os.ReadFile(filepath.Join(vendorDir, "status_enumer.go"))
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(data), qt.Contains, "\npackage vendored\n")
+
+	cmd = exec.Command("go", "build", "./vendored")
+	cmd.Dir = tmpDir
+	output, err = cmd.CombinedOutput()
+	c.Assert(err, qt.IsNil, qt.Commentf("build output: %s", output))
+}
+
+func TestEnumerTemplate(t *testing.T) {
+	c := qt.New(t)
+
+	enumerBin := buildEnumer(c)
+	tmpDir := setupModule(c, filepath.Join("testdata", "simple_iota"))
+
+	tmplFile := filepath.Join(c.TempDir(), "custom.tmpl")
+	tmpl := `// Command: {{.Command}}
+
+package {{.PackageName}}
+{{range .Types}}
+// Custom{{.Name}}Marker is generated by a custom template
+const Custom{{.Name}}Marker = {{quote (upper .Name)}}
+{{end}}`
+	c.Assert(os.WriteFile(tmplFile, []byte(tmpl), 0644), qt.IsNil)
+
+	cmd := exec.Command(enumerBin, "-type=Status", "-template="+tmplFile)
+	cmd.Dir = tmpDir
+	output, err := cmd.CombinedOutput()
+	c.Assert(err, qt.IsNil, qt.Commentf("enumer output: %s", output))
+
+	data, err := os.ReadFile(filepath.Join(tmpDir, "status_enumer.go"))
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(data), qt.Contains, `const CustomStatusMarker = "STATUS"`)
+	c.Assert(string(data), qt.Not(qt.Contains), "func StatusString")
+
+	// A template that doesn't parse is reported without writing anything
+	c.Assert(os.WriteFile(tmplFile, []byte("{{if}}"), 0644), qt.IsNil)
+	c.Assert(os.Remove(filepath.Join(tmpDir, "status_enumer.go")), qt.IsNil)
+	cmd = exec.Command(enumerBin, "-type=Status", "-template="+tmplFile)
+	cmd.Dir = tmpDir
+	output, err = cmd.CombinedOutput()
+	c.Assert(err, qt.IsNotNil)
+	c.Assert(string(output), qt.Contains, "failed to parse template")
+	_, err = os.Stat(filepath.Join(tmpDir, "status_enumer.go"))
+	c.Assert(os.IsNotExist(err), qt.IsTrue)
+}
+
+func TestEnumerPackageArg(t *testing.T) {
+	c := qt.New(t)
+
+	enumerBin := buildEnumer(c)
+	tmpDir := setupModule(c, filepath.Join("testdata", "simple_iota"))
+
+	// Move the package into a subdirectory whose name differs from the package name
+	pkgDir := filepath.Join(tmpDir, "internal", "model")
+	c.Assert(os.MkdirAll(pkgDir, 0755), qt.IsNil)
+	for _, name := range []string{"types.go", "types_test.go"} {
+		c.Assert(os.Rename(filepath.Join(tmpDir, name), filepath.Join(pkgDir, name)), qt.IsNil)
+	}
+
+	cmd := exec.Command(enumerBin, "-type=Status", "-json", "./internal/model")
+	cmd.Dir = tmpDir
+	output, err := cmd.CombinedOutput()
+	c.Assert(err, qt.IsNil, qt.Commentf("enumer output: %s", output))
+
+	// The file should be written into the package directory, not the cwd
+	_, err = os.Stat(filepath.Join(tmpDir, "status_enumer.go"))
+	c.Assert(os.IsNotExist(err), qt.IsTrue)
+
+	data, err := os.ReadFile(filepath.Join(pkgDir, "status_enumer.go"))
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(data), qt.Contains, "\npackage testpkg\n")
+	c.Assert(string(data), qt.Contains, "// Command: enumer -type=Status -json ./internal/model\n")
+
+	cmd = exec.Command("go", "build", "./internal/model")
+	cmd.Dir = tmpDir
+	output, err = cmd.CombinedOutput()
+	c.Assert(err, qt.IsNil, qt.Commentf("build output: %s", output))
+}
+
+func TestEnumerRecursive(t *testing.T) {
+	c := qt.New(t)
+
+	enumerBin := buildEnumer(c)
+	tmpDir := setupModule(c, filepath.Join("testdata", "simple_iota"))
+
+	// Declare the type in two subpackages, alongside one that lacks it
+	for _, dir := range []string{"a", "b", "other"} {
+		c.Assert(os.Mkdir(filepath.Join(tmpDir, dir), 0755), qt.IsNil)
+	}
+	copyFile(c, filepath.Join(tmpDir, "types.go"), filepath.Join(tmpDir, "a", "types.go"))
+	c.Assert(os.Rename(filepath.Join(tmpDir, "types.go"), filepath.Join(tmpDir, "b", "types.go")), qt.IsNil)
+	c.Assert(os.Remove(filepath.Join(tmpDir, "types_test.go")), qt.IsNil)
+	c.Assert(os.WriteFile(filepath.Join(tmpDir, "other", "other.go"), []byte("package other\n\ntype Other int\n"), 0644), qt.IsNil)
+
+	cmd := exec.Command(enumerBin, "-type=Status", "-recursive", "./...")
+	cmd.Dir = tmpDir
+	output, err := cmd.CombinedOutput()
+	c.Assert(err, qt.IsNil, qt.Commentf("enumer output: %s", output))
+
+	for _, dir := range []string{"a", "b"} {
+		_, err := os.Stat(filepath.Join(tmpDir, dir, "status_enumer.go"))
+		c.Assert(err, qt.IsNil)
+	}
+	entries, err := os.ReadDir(filepath.Join(tmpDir, "other"))
+	c.Assert(err, qt.IsNil)
+	c.Assert(entries, qt.HasLen, 1)
+
+	cmd = exec.Command("go", "build", "./...")
+	cmd.Dir = tmpDir
+	output, err = cmd.CombinedOutput()
+	c.Assert(err, qt.IsNil, qt.Commentf("build output: %s", output))
+
+	// Without -recursive, several packages are still an error
+	cmd = exec.Command(enumerBin, "-type=Status", "./...")
+	cmd.Dir = tmpDir
+	output, err = cmd.CombinedOutput()
+	c.Assert(err, qt.IsNotNil)
+	c.Assert(string(output), qt.Contains, "Expected exactly one package, got 3")
+}
+
 func TestEnumer(t *testing.T) {
 	c := qt.New(t)
 
@@ -37,25 +609,7 @@ func runTestCase(c *qt.C, testName, enumerBin string) {
 	c.Helper()
 
 	testDir := filepath.Join("testdata", testName)
-
-	// Create temp directory for this test
-	tmpDir := c.TempDir()
-
-	// Copy all .go files from testdata
-	entries, err := os.ReadDir(testDir)
-	c.Assert(err, qt.IsNil)
-
-	for _, entry := range entries {
-		if entry.IsDir() {
-			continue
-		}
-		name := entry.Name()
-		if strings.HasSuffix(name, ".go") {
-			srcFile := filepath.Join(testDir, name)
-			dstFile := filepath.Join(tmpDir, name)
-			copyFile(c, srcFile, dstFile)
-		}
-	}
+	tmpDir := setupModule(c, testDir)
 
 	// Read enumer.args file
 	argsFile := filepath.Join(testDir, "enumer.args")
@@ -64,16 +618,9 @@ func runTestCase(c *qt.C, testName, enumerBin string) {
 
 	args := parseArgs(string(argsData))
 
-	// Create go.mod BEFORE running enumer (packages.Load needs it)
-	modContent := `module test
-
-go 1.21
-
-require gopkg.in/yaml.v3 v3.0.1
-`
-	modFile := filepath.Join(tmpDir, "go.mod")
-	err = os.WriteFile(modFile, []byte(modContent), 0644)
-	c.Assert(err, qt.IsNil)
+	// An enumer.err file means enumer is expected to fail with that message
+	expectedErr, err := os.ReadFile(filepath.Join(testDir, "enumer.err"))
+	expectFailure := err == nil
 
 	// Run enumer
 	cmd := exec.Command(enumerBin, args...)
@@ -84,6 +631,11 @@ require gopkg.in/yaml.v3 v3.0.1
 	cmd.Stderr = &stderr
 
 	err = cmd.Run()
+	if expectFailure {
+		c.Assert(err, qt.IsNotNil, qt.Commentf("enumer should have failed"))
+		c.Assert(stderr.String(), qt.Contains, strings.TrimSpace(string(expectedErr)))
+		return
+	}
 	if err != nil {
 		c.Logf("stdout: %s", stdout.String())
 		c.Logf("stderr: %s", stderr.String())
@@ -96,8 +648,20 @@ require gopkg.in/yaml.v3 v3.0.1
 	err = cmd.Run()
 	c.Assert(err, qt.IsNil, qt.Commentf("go mod tidy failed"))
 
-	// Run the tests in the temp directory
-	cmd = exec.Command("go", "test", "-v")
+	// Vet and then run the tests in the temp directory, with the build
+	// tags enumer loaded the package with
+	var tagArgs []string
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "-tags=") {
+			tagArgs = append(tagArgs, arg)
+		}
+	}
+	cmd = exec.Command("go", append([]string{"vet"}, tagArgs...)...)
+	cmd.Dir = tmpDir
+	vetOutput, err := cmd.CombinedOutput()
+	c.Assert(err, qt.IsNil, qt.Commentf("go vet failed for %s: %s", testName, vetOutput))
+
+	cmd = exec.Command("go", append([]string{"test", "-v"}, tagArgs...)...)
 	cmd.Dir = tmpDir
 
 	stdout.Reset()
@@ -122,6 +686,53 @@ require gopkg.in/yaml.v3 v3.0.1
 	c.Assert(err, qt.IsNil, qt.Commentf("tests failed for %s", testName))
 }
 
+// setupModule copies the .go files from a testdata directory into a new
+// temp directory with a go.mod, ready for enumer to run in
+func setupModule(c *qt.C, testDir string) string {
+	c.Helper()
+
+	// Create temp directory for this test
+	tmpDir := c.TempDir()
+
+	// Copy all .go files from testdata
+	entries, err := os.ReadDir(testDir)
+	c.Assert(err, qt.IsNil)
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		if strings.HasSuffix(name, ".go") {
+			srcFile := filepath.Join(testDir, name)
+			dstFile := filepath.Join(tmpDir, name)
+			copyFile(c, srcFile, dstFile)
+		}
+	}
+
+	// Create go.mod BEFORE running enumer (packages.Load needs it). A case
+	// can provide its own, e.g. to pin different dependencies
+	modContent := []byte(`module test
+
+go 1.21
+
+require gopkg.in/yaml.v3 v3.0.1
+`)
+	if data, err := os.ReadFile(filepath.Join(testDir, "go.mod")); err == nil {
+		modContent = data
+	}
+
+	// Generated code can import runtime packages from this module
+	root, err := filepath.Abs(".")
+	c.Assert(err, qt.IsNil)
+	modContent = append(modContent, fmt.Sprintf("\nreplace github.com/spaceweasel/enumer => %s\n", root)...)
+	modFile := filepath.Join(tmpDir, "go.mod")
+	err = os.WriteFile(modFile, modContent, 0644)
+	c.Assert(err, qt.IsNil)
+
+	return tmpDir
+}
+
 func buildEnumer(c *qt.C) string {
 	c.Helper()
 
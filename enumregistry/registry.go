@@ -0,0 +1,98 @@
+// Package enumregistry looks up enums generated with enumer -registry by
+// type name, qualified by the package path so types of the same name in
+// different packages don't collide. Generic code such as a config loader can
+// then parse and format values without importing each enum's functions:
+//
+//	v, err := enumregistry.Parse("example.com/app/model.Status", "Success")
+//
+// Types register themselves from an init function in the generated code.
+package enumregistry
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// ErrUnknownType is wrapped by the errors returned for a type name that
+// hasn't been registered
+var ErrUnknownType = errors.New("unknown enum type")
+
+// ErrDuplicateType is wrapped by the error Register returns for a type name
+// that is already registered
+var ErrDuplicateType = errors.New("enum type already registered")
+
+type entry struct {
+	parse  func(string) (int64, error)
+	format func(int64) (string, error)
+}
+
+var (
+	mu      sync.RWMutex
+	entries = make(map[string]entry)
+)
+
+// Register makes an enum available by name, which is the package path and
+// type name joined by a dot, e.g. example.com/app/model.Status. If the name
+// is already registered, the first registration is kept and an error
+// wrapping ErrDuplicateType is returned, which the generated init functions
+// panic with.
+func Register(name string, parse func(string) (int64, error), format func(int64) (string, error)) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if parse == nil || format == nil {
+		panic("enumregistry: Register functions are nil for " + name)
+	}
+	if _, ok := entries[name]; ok {
+		return fmt.Errorf("%w: %q", ErrDuplicateType, name)
+	}
+	entries[name] = entry{parse: parse, format: format}
+	return nil
+}
+
+// Parse returns the value of the named enum represented by s. The name is
+// the package path and type name, e.g. example.com/app/model.Status
+func Parse(name, s string) (int64, error) {
+	e, err := lookup(name)
+	if err != nil {
+		return 0, err
+	}
+	return e.parse(s)
+}
+
+// String returns the string representation of a value of the named enum,
+// named as for Parse
+func String(name string, v int64) (string, error) {
+	e, err := lookup(name)
+	if err != nil {
+		return "", err
+	}
+	return e.format(v)
+}
+
+// Names returns the registered type names, the package path and type name
+// of each, in sorted order
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	names := make([]string, 0, len(entries))
+	for name := range entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func lookup(name string) (entry, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	e, ok := entries[name]
+	if !ok {
+		return entry{}, fmt.Errorf("%w %q", ErrUnknownType, name)
+	}
+	return e, nil
+}
@@ -0,0 +1,61 @@
+package enumregistry
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestRegistry(t *testing.T) {
+	c := qt.New(t)
+
+	names := []string{"Zero", "One", "Two"}
+	parse := func(s string) (int64, error) {
+		for i, name := range names {
+			if name == s {
+				return int64(i), nil
+			}
+		}
+		return 0, errors.New(s + " is not a valid Number")
+	}
+	format := func(v int64) (string, error) {
+		if v < 0 || v >= int64(len(names)) {
+			return "", errors.New(strconv.FormatInt(v, 10) + " is not a valid Number")
+		}
+		return names[v], nil
+	}
+	c.Assert(Register("example.com/a.Number", parse, format), qt.IsNil)
+
+	v, err := Parse("example.com/a.Number", "Two")
+	c.Assert(err, qt.IsNil)
+	c.Assert(v, qt.Equals, int64(2))
+
+	_, err = Parse("example.com/a.Number", "Three")
+	c.Assert(err, qt.ErrorMatches, "Three is not a valid Number")
+
+	s, err := String("example.com/a.Number", 1)
+	c.Assert(err, qt.IsNil)
+	c.Assert(s, qt.Equals, "One")
+
+	_, err = Parse("Missing", "Two")
+	c.Assert(errors.Is(err, ErrUnknownType), qt.IsTrue)
+	c.Assert(err, qt.ErrorMatches, `unknown enum type "Missing"`)
+
+	_, err = String("Missing", 1)
+	c.Assert(errors.Is(err, ErrUnknownType), qt.IsTrue)
+
+	_, err = Parse("Number", "Two")
+	c.Assert(errors.Is(err, ErrUnknownType), qt.IsTrue)
+
+	// A type of the same name in another package is a different type
+	c.Assert(Register("example.com/b.Number", parse, format), qt.IsNil)
+	c.Assert(Names(), qt.Contains, "example.com/a.Number")
+	c.Assert(Names(), qt.Contains, "example.com/b.Number")
+
+	err = Register("example.com/a.Number", parse, format)
+	c.Assert(errors.Is(err, ErrDuplicateType), qt.IsTrue)
+	c.Assert(err, qt.ErrorMatches, `enum type already registered: "example.com/a.Number"`)
+	c.Assert(func() { Register("Other", nil, format) }, qt.PanicMatches, "enumregistry: Register functions are nil for Other")
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+)
+
+// modeFlag is a flag that can be given on its own like a boolean flag, or
+// with one of a fixed set of modes, e.g. -json or -json=number
+type modeFlag struct {
+	value string
+	def   string
+	modes []string
+}
+
+// newModeFlag defines a mode flag; given on its own it selects the first mode
+func newModeFlag(name, usage string, modes ...string) *modeFlag {
+	f := &modeFlag{def: modes[0], modes: modes}
+	flag.Var(f, name, usage)
+	return f
+}
+
+func (f *modeFlag) String() string {
+	return f.value
+}
+
+func (f *modeFlag) Set(s string) error {
+	switch s {
+	case "true":
+		s = f.def
+	case "false":
+		f.value = ""
+		return nil
+	}
+	for _, mode := range f.modes {
+		if s == mode {
+			f.value = s
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown mode %q, must be one of %s", s, strings.Join(f.modes, ", "))
+}
+
+// IsBoolFlag allows the flag to be given without a value
+func (f *modeFlag) IsBoolFlag() bool {
+	return true
+}
+
+// arg returns the flag as it should appear in a command line, or "" if
+// it isn't set
+func (f *modeFlag) arg(name string) string {
+	switch f.value {
+	case "":
+		return ""
+	case f.def:
+		return "-" + name
+	}
+	return fmt.Sprintf("-%s=%s", name, f.value)
+}
+
+// listFlag is a flag that can be repeated, each occurrence adding a
+// comma-separated list of values, e.g. -type=A,B -type=C
+type listFlag []string
+
+// newListFlag defines a list flag
+func newListFlag(name, usage string) *listFlag {
+	f := new(listFlag)
+	flag.Var(f, name, usage)
+	return f
+}
+
+func (f *listFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *listFlag) Set(s string) error {
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*f = append(*f, v)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,1208 @@
+// Package gen generates helper methods for Go enums: string conversion,
+// parsing, validation and optional marshaling, SQL and bitmask methods.
+//
+// It is the engine behind the enumer command, and can be driven directly
+// by other tools:
+//
+//	src, err := gen.Generate(gen.Config{
+//		Package: pkg, // loaded with gen.LoadMode
+//		Types:   []string{"Status"},
+//		Options: gen.Options{JSON: gen.JSONString},
+//	})
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build/constraint"
+	"go/constant"
+	"go/format"
+	"go/token"
+	"go/types"
+	"math"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// LoadMode is the packages.LoadMode needed by Generate. Imports and their
+// types are required for packages that import anything, including a
+// previously generated file.
+const LoadMode = packages.NeedName | packages.NeedFiles | packages.NeedSyntax |
+	packages.NeedTypes | packages.NeedTypesInfo | packages.NeedImports | packages.NeedDeps
+
+// JSON marshaling modes
+const (
+	JSONString   = "string"   // marshal as the string representation
+	JSONNumber   = "number"   // marshal as the underlying number, accepting strings too
+	JSONLenient  = "lenient"  // marshal as the string representation, accepting numbers too
+	JSONPreserve = "preserve" // like lenient, but keep unknown values as numbers
+)
+
+// Line comment modes
+const (
+	LineCommentStrict   = "strict"   // every constant must have a comment, or none
+	LineCommentOptional = "optional" // constants without a comment use their name
+	LineCommentBoth     = "both"     // like strict, but the names parse too
+)
+
+// Ways of looking values up by string, and strings up by value
+const (
+	ParseModeMap    = "map"    // index maps built at init
+	ParseModeSwitch = "switch" // switch statements, so nothing is built at init
+)
+
+// Ways of handling methods the user has already declared
+const (
+	ExistingSkip  = "skip"  // don't generate the method
+	ExistingError = "error" // fail, so the user can remove it
+)
+
+// SQL storage modes
+const (
+	SQLText = "text" // store the string representation
+	SQLInt  = "int"  // store the underlying number as an int64
+)
+
+// Options controls what is generated and how constant names become strings
+type Options struct {
+	TrimPrefix       string // comma-separated list of prefixes
+	TrimSuffix       string
+	AddPrefix        string
+	Transform        string
+	BuildTags        string
+	GoFile           string // only use constants declared in this file, e.g. $GOFILE
+	Comment          string // banner added below the generated code header; may span lines
+	IDPrefix         string // prepended to generated identifiers other than methods
+	YAMLVersion      int    // major version of gopkg.in/yaml to target; 0 means 3
+	LineComment      string // line comment mode; empty ignores comments
+	SQL              string // SQL storage mode; empty disables the methods
+	Nullable         bool
+	JSON             string // JSON marshaling mode; empty disables the methods
+	YAML             bool
+	YAMLBuildTag     bool // leave the YAML methods to GenerateYAML, guarded by the yaml build tag
+	Text             bool
+	Binary           bool
+	GQL              bool
+	FlagValue        bool
+	XML              bool
+	Bitmask          bool
+	BitmaskBits      bool // Valid accepts any combination of flags, not only named constants
+	CaseInsensitive  bool
+	ParseNumber      bool
+	ParsePlaceholder bool   // parse the InvalidFormat form String gives unnamed values
+	InvalidFormat    string // format of unnamed values with one %d or %v verb; empty means Foo(%d)
+	TrimSpace        bool
+	Descriptions     bool
+	Labels           bool
+	GoString         bool // generate a GoString method giving constant names for %#v
+	Iter             bool
+	Navigation       bool
+	WithDefault      bool
+	Normalize        bool // generate NormalizeFoo, returning the String form of a parsed string
+	CSV              bool
+	Batch            bool // generate FooStrings, reporting every string that isn't valid
+	SetType          bool // generate a FooSet type
+	Registry         bool
+	StrictZero       bool
+	DocValues        bool
+	Deprecation      bool   // generate FooActiveValues, leaving out deprecated constants
+	ValidateFunc     bool   // generate ValidateFoo for checking several values
+	Existing         string // handling of a hand-written String method; empty means skip
+	ParseMode        string // lookup implementation; empty means map
+	Exclude          string // comma-separated list of methods and functions to leave out
+}
+
+// Excludable lists the methods and functions Options.Exclude can leave out.
+// Functions are named without the type, e.g. Values for FooValues.
+var Excludable = []string{"AppendString", "Entries", "Len", "Max", "Min", "Names", "String", "Valid", "Values", "ValuesMap"}
+
+// excluded returns the set of names listed in Exclude
+func (o Options) excluded() map[string]bool {
+	if o.Exclude == "" {
+		return nil
+	}
+	names := make(map[string]bool)
+	for _, name := range strings.Split(o.Exclude, ",") {
+		names[strings.TrimSpace(name)] = true
+	}
+	return names
+}
+
+// Validate checks the options for values that can't be generated
+func (o Options) Validate() error {
+	if _, err := transformName("", o.Transform); err != nil {
+		return err
+	}
+	switch o.JSON {
+	case "", JSONString, JSONNumber, JSONLenient, JSONPreserve:
+	default:
+		return fmt.Errorf("unknown json mode %q", o.JSON)
+	}
+	switch o.SQL {
+	case "", SQLText, SQLInt:
+	default:
+		return fmt.Errorf("unknown sql mode %q", o.SQL)
+	}
+	switch o.LineComment {
+	case "", LineCommentStrict, LineCommentOptional, LineCommentBoth:
+	default:
+		return fmt.Errorf("unknown linecomment mode %q", o.LineComment)
+	}
+	switch o.Existing {
+	case "", ExistingSkip, ExistingError:
+	default:
+		return fmt.Errorf("unknown existing mode %q", o.Existing)
+	}
+	switch o.ParseMode {
+	case "", ParseModeMap, ParseModeSwitch:
+	default:
+		return fmt.Errorf("unknown parse mode %q", o.ParseMode)
+	}
+	for name := range o.excluded() {
+		if !slices.Contains(Excludable, name) {
+			return fmt.Errorf("%q can't be excluded, must be one of %s", name, strings.Join(Excludable, ", "))
+		}
+	}
+	if o.IDPrefix != "" && !token.IsIdentifier(o.IDPrefix) {
+		return fmt.Errorf("invalid identifier prefix %q", o.IDPrefix)
+	}
+	if _, _, err := splitInvalidFormat(o.InvalidFormat); err != nil {
+		return err
+	}
+	if o.BitmaskBits && !o.Bitmask {
+		return fmt.Errorf("bitwise bitmask validation requires bitmask methods")
+	}
+	if o.Iter && o.Bitmask {
+		return fmt.Errorf("iterators can't be generated with bitmask methods, as both define FooAll")
+	}
+	if o.FlagValue && o.Bitmask {
+		return fmt.Errorf("flag methods can't be generated with bitmask methods, as both define Set")
+	}
+	if o.ParseMode == ParseModeSwitch && o.Bitmask {
+		return fmt.Errorf("switch parse mode can't be used with bitmask methods, which look up combined flags")
+	}
+	if o.Nullable && o.SQL == "" {
+		return fmt.Errorf("nullable types require SQL methods")
+	}
+	if o.YAMLBuildTag && !o.YAML {
+		return fmt.Errorf("a YAML build tag requires YAML methods")
+	}
+	if o.YAMLVersion != 0 && o.YAMLVersion != 2 && o.YAMLVersion != 3 {
+		return fmt.Errorf("unsupported yaml version %d, must be 2 or 3", o.YAMLVersion)
+	}
+	if o.BuildTags != "" {
+		if _, err := constraint.Parse("//go:build " + o.BuildTags); err != nil {
+			return fmt.Errorf("invalid build tags %q: %w", o.BuildTags, err)
+		}
+	}
+	return nil
+}
+
+// Config holds everything needed to generate a file
+type Config struct {
+	// Package is the loaded package declaring the types. It must be loaded
+	// with at least LoadMode.
+	Package *packages.Package
+
+	// Types are the names of the types to generate code for
+	Types []string
+
+	// PackageName overrides the package clause of the generated file,
+	// which defaults to the name of Package. Identifiers aren't qualified,
+	// so the file must still be compiled alongside the type declarations
+	PackageName string
+
+	// Command is recorded in the header of the generated file
+	Command string
+
+	// Version is the version of enumer, recorded in the header when set
+	Version string
+
+	// Platform is the GOOS/GOARCH the package was loaded for, e.g.
+	// linux/amd64. It must be set to generate types with constants whose
+	// values depend on the platform, and is then recorded in the header
+	Platform string
+
+	// Template replaces the built-in template when set. It is executed
+	// against TemplateData, with the same functions available
+	Template string
+
+	Options
+}
+
+// Element represents a single enum constant
+type Element struct {
+	Name        string
+	Value       string
+	StringValue string
+	SingleBit   bool
+	Alias       bool
+	ParseNames  []string // additional strings accepted when parsing
+	Description string   // text of the constant's doc comment
+	Deprecated  bool     // the doc comment has a Deprecated: paragraph
+	Label       string   // trimmed name split into words for display
+
+	val       constant.Value
+	pos       token.Position
+	commented bool // string taken from its line comment
+}
+
+// Enum represents an enum type and its constants
+type Enum struct {
+	Name       string
+	Underlying string
+	Bits       int
+	Unsigned   bool
+	IsString   bool
+	Elements   []Element
+
+	// Dense is set when the values form a contiguous range starting at
+	// Base, so String can slice a single string rather than use a map
+	Dense bool
+	Base  int64
+
+	// HasString is set when the type already has a hand-written String
+	// method, which is used instead of generating one
+	HasString bool
+
+	// InvalidPrefix and InvalidSuffix surround the number String gives a
+	// value without a name, e.g. "Foo(" and ")"
+	InvalidPrefix string
+	InvalidSuffix string
+
+	platform string // why a constant's value depends on the platform, if one does
+}
+
+// Flags returns the distinct single-bit constants in declaration order
+func (e Enum) Flags() []Element {
+	var flags []Element
+	for _, el := range e.Elements {
+		if el.SingleBit && !el.Alias {
+			flags = append(flags, el)
+		}
+	}
+	sort.SliceStable(flags, func(a, b int) bool {
+		pa, pb := flags[a].pos, flags[b].pos
+		if pa.Filename != pb.Filename {
+			return pa.Filename < pb.Filename
+		}
+		return pa.Offset < pb.Offset
+	})
+	return flags
+}
+
+// EmptySet returns the string the String method of a bitmask gives a value
+// with no flags set, e.g. Foo(0), or "" if a constant names it
+func (e Enum) EmptySet() string {
+	if e.HasString {
+		return ""
+	}
+	for _, el := range e.Elements {
+		if constant.Sign(el.val) == 0 {
+			return ""
+		}
+	}
+	return e.InvalidPrefix + "0" + e.InvalidSuffix
+}
+
+// ActiveValues returns the distinct values that aren't deprecated, in the
+// same order as Values. A value is only deprecated if every constant with
+// it is, so a value keeps being offered under a newer alias
+func (e Enum) ActiveValues() []Element {
+	var active []Element
+	for i, el := range e.Elements {
+		if el.Alias {
+			continue
+		}
+		deprecated := el.Deprecated
+		for j := i + 1; j < len(e.Elements) && e.Elements[j].Alias; j++ {
+			deprecated = deprecated && e.Elements[j].Deprecated
+		}
+		if !deprecated {
+			active = append(active, el)
+		}
+	}
+	return active
+}
+
+// NameString returns the concatenation of each distinct value's string,
+// which a dense String method slices
+func (e Enum) NameString() string {
+	var b strings.Builder
+	for _, el := range e.Elements {
+		if !el.Alias {
+			b.WriteString(el.StringValue)
+		}
+	}
+	return b.String()
+}
+
+// NameIndex returns the offsets of each distinct value's string within
+// the concatenation of all of them, for use by a dense String method
+func (e Enum) NameIndex() []int {
+	index := []int{0}
+	for _, el := range e.Elements {
+		if !el.Alias {
+			index = append(index, index[len(index)-1]+len(el.StringValue))
+		}
+	}
+	return index
+}
+
+// DocValues returns a doc comment sentence listing the string of each
+// distinct value, wrapped into lines short enough for a comment
+func (e Enum) DocValues() []string {
+	const width = 72
+	var strs []string
+	for _, el := range e.Elements {
+		if el.Alias {
+			continue
+		}
+		// Strings that would break the comment are written as Go strings
+		s := el.StringValue
+		if strings.ContainsFunc(s, func(r rune) bool { return !unicode.IsPrint(r) }) {
+			s = strconv.Quote(s)
+		}
+		strs = append(strs, s)
+	}
+
+	var lines []string
+	line := "Valid values:"
+	for i, s := range strs {
+		word := s + ","
+		if i == len(strs)-1 {
+			word = s + "."
+		}
+		if len(line)+1+len(word) > width {
+			lines = append(lines, line)
+			line = word
+		} else {
+			line += " " + word
+		}
+	}
+	return append(lines, line)
+}
+
+// QuoteJSON reports whether strconv.AppendQuote quotes every string String
+// can return exactly as json.Marshal would, so MarshalJSON can build the
+// quoted string itself. That holds for printable ASCII other than the
+// HTML characters json.Marshal escapes.
+func (e Enum) QuoteJSON() bool {
+	if e.IsString || e.HasString || !quotesLikeJSON(e.Name) {
+		return false
+	}
+	for _, el := range e.Elements {
+		if !quotesLikeJSON(el.StringValue) {
+			return false
+		}
+	}
+	return true
+}
+
+func quotesLikeJSON(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c < ' ' || c > '~' || c == '<' || c == '>' || c == '&' {
+			return false
+		}
+	}
+	return true
+}
+
+// TemplateData holds all data needed for template execution
+type TemplateData struct {
+	PackageName string
+	PkgPath     string // path of the package declaring the types
+	Types       []Enum
+	Command     string
+	Version     string
+	Platform    string // set when some values depend on the platform
+
+	// Excluded is the set of methods and functions to leave out
+	Excluded map[string]bool
+
+	Options
+}
+
+// HasIntegerTypes reports whether any of the types has an integer underlying type
+func (d TemplateData) HasIntegerTypes() bool {
+	for _, enum := range d.Types {
+		if !enum.IsString {
+			return true
+		}
+	}
+	return false
+}
+
+// QuotesJSON reports whether any MarshalJSON quotes its string with strconv
+func (d TemplateData) QuotesJSON() bool {
+	if d.JSON == "" || d.JSON == JSONNumber {
+		return false
+	}
+	for _, enum := range d.Types {
+		if enum.QuoteJSON() {
+			return true
+		}
+	}
+	return false
+}
+
+// CommentLines returns the -comment banner as comment lines, one for each
+// line of the text
+func (d TemplateData) CommentLines() []string {
+	if d.Comment == "" {
+		return nil
+	}
+	text := strings.ReplaceAll(strings.TrimRight(d.Comment, "\r\n"), "\r\n", "\n")
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimRight(line, " \t"); line == "" {
+			lines = append(lines, "//")
+		} else {
+			lines = append(lines, "// "+line)
+		}
+	}
+	return lines
+}
+
+// AppendsNumbers reports whether any AppendString formats unknown values
+// itself, rather than calling a hand-written String method. None do when
+// AppendString is excluded
+func (d TemplateData) AppendsNumbers() bool {
+	if d.Excluded["AppendString"] {
+		return false
+	}
+	for _, enum := range d.Types {
+		if !enum.IsString && !enum.HasString {
+			return true
+		}
+	}
+	return false
+}
+
+// PlusBuildLines returns the legacy // +build lines equivalent to the
+// -buildtags expression, for toolchains older than Go 1.17
+func (d TemplateData) PlusBuildLines() []string {
+	expr, err := constraint.Parse("//go:build " + d.BuildTags)
+	if err != nil {
+		return nil
+	}
+	lines, err := constraint.PlusBuildLines(expr)
+	if err != nil {
+		return nil
+	}
+	return lines
+}
+
+// YAMLTag is the build tag guarding the file of YAML methods generated by
+// GenerateYAML
+const YAMLTag = "yaml"
+
+// Generate returns the formatted source of a file containing the generated
+// code for all of the configured types
+func Generate(cfg Config) ([]byte, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Parse the template first, so problems with a custom one are reported
+	// before anything else
+	text := codeTemplate + yamlTemplate
+	if cfg.Template != "" {
+		text = cfg.Template
+	}
+	tmpl, err := parseTemplate(text)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := templateData(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.YAMLBuildTag {
+		data.YAML = false
+	}
+	return generateCode(tmpl, data)
+}
+
+// GenerateYAML returns the formatted source of a file containing the YAML
+// methods of the configured types, for use with Options.YAMLBuildTag. The
+// file is only built with the yaml build tag, so packages built without it
+// don't depend on gopkg.in/yaml
+func GenerateYAML(cfg Config) ([]byte, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	tmpl, err := parseTemplate(yamlFileTemplate + yamlTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := templateData(cfg)
+	if err != nil {
+		return nil, err
+	}
+	data.BuildTags = YAMLTag
+	if cfg.BuildTags != "" {
+		data.BuildTags = fmt.Sprintf("%s && (%s)", YAMLTag, cfg.BuildTags)
+	}
+	return generateCode(tmpl, data)
+}
+
+// templateData processes the configured types into the data the templates
+// are executed against
+func templateData(cfg Config) (TemplateData, error) {
+	var enums []Enum
+	for _, typeName := range cfg.Types {
+		enum, err := processType(cfg.Package, typeName, cfg.Options)
+		if err != nil {
+			return TemplateData{}, fmt.Errorf("failed to process type %s: %w", typeName, err)
+		}
+		if len(enum.Elements) == 0 {
+			return TemplateData{}, fmt.Errorf("no constants found for type %s", typeName)
+		}
+		if enum.platform != "" && cfg.Platform == "" {
+			return TemplateData{}, fmt.Errorf("failed to process type %s: the value of %s, so the output differs between platforms; set GOOS and GOARCH to pin the platform to generate for", typeName, enum.platform)
+		}
+		enums = append(enums, enum)
+	}
+
+	packageName := cfg.Package.Name
+	if cfg.PackageName != "" {
+		if !token.IsIdentifier(cfg.PackageName) {
+			return TemplateData{}, fmt.Errorf("invalid package name %q", cfg.PackageName)
+		}
+		packageName = cfg.PackageName
+	}
+
+	// The platform only needs recording if it changed the output
+	var platform string
+	for _, enum := range enums {
+		if enum.platform != "" {
+			platform = cfg.Platform
+		}
+	}
+
+	data := TemplateData{
+		PackageName: packageName,
+		PkgPath:     cfg.Package.PkgPath,
+		Types:       enums,
+		Command:     cfg.Command,
+		Version:     cfg.Version,
+		Platform:    platform,
+		Excluded:    cfg.Options.excluded(),
+		Options:     cfg.Options,
+	}
+	return data, nil
+}
+
+// processType extracts all constants for a given type
+func processType(pkg *packages.Package, typeName string, opts Options) (Enum, error) {
+	// Find the type
+	obj := pkg.Types.Scope().Lookup(typeName)
+	if obj == nil {
+		return Enum{}, fmt.Errorf("type %s not found", typeName)
+	}
+
+	tn, ok := obj.(*types.TypeName)
+	if !ok {
+		return Enum{}, fmt.Errorf("%s is not a type", typeName)
+	}
+
+	// Methods can't be declared on an alias other than through the type it
+	// stands for, so the code would duplicate that type's
+	if tn.IsAlias() {
+		return Enum{}, fmt.Errorf("%s is an alias of %s; generate for that type instead", typeName, types.TypeString(types.Unalias(tn.Type()), types.RelativeTo(pkg.Types)))
+	}
+
+	// Only integer and string kinds have constants that can be enumerated
+	targetType := obj.Type()
+	basic, ok := targetType.Underlying().(*types.Basic)
+	if !ok || basic.Info()&(types.IsInteger|types.IsString) == 0 {
+		return Enum{}, fmt.Errorf("type %s has underlying kind %s which enumer cannot generate for", typeName, kindName(targetType.Underlying()))
+	}
+
+	enum := Enum{
+		Name:       typeName,
+		Underlying: basic.Name(),
+		Bits:       bitSize(basic),
+		Unsigned:   basic.Info()&types.IsUnsigned != 0,
+		IsString:   basic.Info()&types.IsString != 0,
+	}
+	if opts.InvalidFormat == "" {
+		enum.InvalidPrefix, enum.InvalidSuffix = typeName+"(", ")"
+	} else {
+		enum.InvalidPrefix, enum.InvalidSuffix, _ = splitInvalidFormat(opts.InvalidFormat)
+	}
+	if enum.IsString && opts.Bitmask {
+		return Enum{}, fmt.Errorf("bitmask methods cannot be generated for string type %s", typeName)
+	}
+	if enum.IsString && (opts.JSON == JSONNumber || opts.JSON == JSONLenient || opts.JSON == JSONPreserve) {
+		return Enum{}, fmt.Errorf("numeric JSON methods cannot be generated for string type %s", typeName)
+	}
+	if enum.IsString && opts.SQL == SQLInt {
+		return Enum{}, fmt.Errorf("integer SQL methods cannot be generated for string type %s", typeName)
+	}
+	if enum.IsString && opts.Registry {
+		return Enum{}, fmt.Errorf("registry functions cannot be generated for string type %s", typeName)
+	}
+
+	// Iterate through all files in the package
+	platforms := newPlatformChecker(pkg)
+	for _, file := range pkg.Syntax {
+		if opts.GoFile != "" && filepath.Base(pkg.Fset.Position(file.Pos()).Filename) != filepath.Base(opts.GoFile) {
+			continue
+		}
+
+		// A previous run's output declares constants of the type too
+		if generatedByEnumer(file) {
+			continue
+		}
+		for _, decl := range file.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.CONST {
+				continue
+			}
+
+			for _, spec := range gd.Specs {
+				vspec := spec.(*ast.ValueSpec)
+				comments := nameComments(pkg.Fset, file, vspec)
+
+				for nameIdx, name := range vspec.Names {
+					if name.Name == "_" {
+						continue
+					}
+
+					// Check if this constant matches our target type. Constants
+					// declared with an alias of it (type A = T) match, while
+					// those of a type defined from it (type D T) don't
+					constObj := pkg.TypesInfo.Defs[name]
+					if constObj == nil || !types.Identical(types.Unalias(constObj.Type()), targetType) {
+						continue
+					}
+
+					// Get the constant value
+					constValue := constObj.(*types.Const).Val()
+					if reason := platforms.dependence(constObj.(*types.Const)); reason != "" && enum.platform == "" {
+						enum.platform = fmt.Sprintf("%s depends on %s", name.Name, reason)
+					}
+
+					// Get string value (trim prefix and suffix if required)
+					stringValue := trimPrefixes(name.Name, opts.TrimPrefix)
+					if opts.TrimSuffix != "" {
+						stringValue = strings.TrimSuffix(stringValue, opts.TrimSuffix)
+					}
+
+					// Labels are built from the trimmed name before it's reshaped
+					label := strings.Join(splitWords(stringValue), " ")
+
+					// Reshape the name if required
+					stringValue, err := transformName(stringValue, opts.Transform)
+					if err != nil {
+						return Enum{}, err
+					}
+
+					// Override string value with comment if present. Further
+					// comma-separated names in the comment are accepted when parsing.
+					// String based enums are represented by their value, so ignore it
+					var parseNames []string
+					commented := false
+					if nc := comments[nameIdx]; opts.LineComment != "" && nc.group != nil && !enum.IsString {
+						names, err := commentNames(nc.group.Text(), constValue)
+						if err != nil {
+							return Enum{}, fmt.Errorf("line comment for %s: %w", name.Name, err)
+						}
+
+						// A comment shared by several constants names each in turn
+						if nc.count > 1 {
+							if len(names) != nc.count {
+								return Enum{}, fmt.Errorf("line comment for %s must give one name for each of the %d constants declared with it", name.Name, nc.count)
+							}
+							names = names[nc.index : nc.index+1]
+						}
+						if len(names) > 0 {
+							// The string the constant would have had keeps parsing
+							parseNames = names[1:]
+							if opts.LineComment == LineCommentBoth && !slices.Contains(names, stringValue) {
+								parseNames = append(slices.Clip(parseNames), stringValue)
+							}
+							stringValue = names[0]
+							commented = true
+						}
+					}
+
+					// A constant declared on its own documents it in the declaration
+					doc := vspec.Doc
+					if doc == nil && !gd.Lparen.IsValid() {
+						doc = gd.Doc
+					}
+					var description string
+					if doc != nil {
+						description = strings.TrimSpace(doc.Text())
+					}
+
+					// Namespace the strings if required
+					stringValue = opts.AddPrefix + stringValue
+					for i := range parseNames {
+						parseNames[i] = opts.AddPrefix + parseNames[i]
+					}
+
+					// String based enums are represented by their value
+					if enum.IsString {
+						stringValue = constant.StringVal(constValue)
+					}
+
+					enum.Elements = append(enum.Elements, Element{
+						Name:        name.Name,
+						Value:       constValue.ExactString(),
+						StringValue: stringValue,
+						SingleBit:   isSingleBit(constValue),
+						ParseNames:  parseNames,
+						Description: description,
+						Deprecated:  isDeprecated(description),
+						Label:       label,
+						commented:   commented,
+						val:         constValue,
+						pos:         pkg.Fset.Position(name.Pos()),
+					})
+
+				}
+			}
+		}
+	}
+
+	// Sort by value, then by declaration position, so the output doesn't
+	// depend on the order in which the package files were parsed
+	sort.Slice(enum.Elements, func(a, b int) bool {
+		ea, eb := enum.Elements[a], enum.Elements[b]
+		if !constant.Compare(ea.val, token.EQL, eb.val) {
+			return constant.Compare(ea.val, token.LSS, eb.val)
+		}
+		if ea.pos.Filename != eb.pos.Filename {
+			return ea.pos.Filename < eb.pos.Filename
+		}
+		return ea.pos.Offset < eb.pos.Offset
+	})
+
+	// Constants sharing the value of an earlier one are aliases; only the
+	// first is used when converting a value to a string
+	for i := 1; i < len(enum.Elements); i++ {
+		if constant.Compare(enum.Elements[i-1].val, token.EQL, enum.Elements[i].val) {
+			enum.Elements[i].Alias = true
+		}
+	}
+
+	// Mixing commented and uncommented constants is usually a mistake, as
+	// the uncommented ones quietly fall back to their names
+	if (opts.LineComment == LineCommentStrict || opts.LineComment == LineCommentBoth) && !enum.IsString {
+		var commented bool
+		var missing []string
+		for _, e := range enum.Elements {
+			switch {
+			case e.Alias:
+			case e.commented:
+				commented = true
+			default:
+				missing = append(missing, e.Name)
+			}
+		}
+		if commented && len(missing) > 0 {
+			return Enum{}, fmt.Errorf("constants %s of type %s have no line comment; comment every constant, or use -linecomment=optional", strings.Join(missing, ", "), typeName)
+		}
+	}
+
+	// The zero value is reserved to mean unset
+	if opts.StrictZero {
+		for _, e := range enum.Elements {
+			if isZero(e.val) {
+				return Enum{}, fmt.Errorf("constant %s of type %s is zero, which -strictzero reserves for unset values", e.Name, typeName)
+			}
+		}
+	}
+
+	// Methods from previously generated files will be replaced, so only
+	// hand-written ones count
+	if pos, ok := declaredMethod(pkg, targetType, "String"); ok {
+		if opts.Existing == ExistingError && !opts.excluded()["String"] {
+			return Enum{}, fmt.Errorf("type %s already declares String at %s; remove it, or use -existing=skip to keep it", typeName, pos)
+		}
+		enum.HasString = true
+	}
+
+	if !enum.IsString && !opts.Bitmask && !enum.HasString {
+		enum.Base, enum.Dense = denseBase(enum.Elements)
+	}
+
+	// Each string must identify a single constant, otherwise parsing is ambiguous
+	seen := make(map[string]string)
+	for _, e := range enum.Elements {
+		for _, s := range append([]string{e.StringValue}, e.ParseNames...) {
+			if other, ok := seen[s]; ok {
+				return Enum{}, fmt.Errorf("constants %s and %s both have the string representation %q", other, e.Name, s)
+			}
+			seen[s] = e.Name
+		}
+	}
+
+	return enum, nil
+}
+
+// denseBase returns the first value, and whether the distinct values are
+// contiguous from it with a non-negative base and names short enough for
+// uint16 offsets
+func denseBase(elements []Element) (int64, bool) {
+	var base, next int64
+	var length int
+	for i, e := range elements {
+		if e.Alias {
+			continue
+		}
+		v, exact := constant.Int64Val(e.val)
+		if !exact {
+			return 0, false
+		}
+		if i == 0 {
+			base, next = v, v
+		}
+		if v != next {
+			return 0, false
+		}
+		next++
+		length += len(e.StringValue)
+	}
+	if len(elements) == 0 || base < 0 || length > math.MaxUint16 {
+		return 0, false
+	}
+	return base, true
+}
+
+// commentNames splits a line comment into its comma-separated names,
+// ignoring empty ones. A name containing a %d or %v verb is a format for
+// the constant's value, so "code-%d" becomes "code-2", and is checked as
+// an InvalidFormat is.
+func commentNames(comment string, val constant.Value) ([]string, error) {
+	var names []string
+	for _, name := range strings.Split(comment, ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		if strings.Contains(name, "%d") || strings.Contains(name, "%v") {
+			prefix, suffix, err := splitInvalidFormat(name)
+			if err != nil {
+				return nil, err
+			}
+			name = prefix + val.ExactString() + suffix
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// splitInvalidFormat returns the text either side of the verb of an
+// InvalidFormat, which must have exactly one %d or %v. A literal % is
+// written %%
+func splitInvalidFormat(format string) (string, string, error) {
+	if format == "" {
+		return "", "", nil
+	}
+	var parts [2]strings.Builder
+	verbs := 0
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			parts[min(verbs, 1)].WriteByte(format[i])
+			continue
+		}
+		i++
+		switch {
+		case i < len(format) && format[i] == '%':
+			parts[min(verbs, 1)].WriteByte('%')
+		case i < len(format) && (format[i] == 'd' || format[i] == 'v'):
+			verbs++
+		default:
+			return "", "", fmt.Errorf("invalid format %q can only use the %%d or %%v verb, or %%%% for a literal %%", format)
+		}
+	}
+	if verbs != 1 {
+		return "", "", fmt.Errorf("invalid format %q must have exactly one %%d or %%v verb for the value", format)
+	}
+	return parts[0].String(), parts[1].String(), nil
+}
+
+// isDeprecated reports whether doc comment text has a paragraph starting
+// with "Deprecated: ", the convention recognised by go vet and gopls
+func isDeprecated(doc string) bool {
+	for _, para := range strings.Split(doc, "\n\n") {
+		if strings.HasPrefix(para, "Deprecated: ") {
+			return true
+		}
+	}
+	return false
+}
+
+// trimPrefixes trims the first of a comma-separated list of prefixes that
+// matches the name. Longer prefixes are tried first, so "StatusOld" is
+// trimmed in full rather than leaving "Old" behind after "Status".
+func trimPrefixes(name, prefixes string) string {
+	if prefixes == "" {
+		return name
+	}
+	list := strings.Split(prefixes, ",")
+	sort.SliceStable(list, func(a, b int) bool {
+		return len(list[a]) > len(list[b])
+	})
+	for _, prefix := range list {
+		if prefix != "" && strings.HasPrefix(name, prefix) {
+			return strings.TrimPrefix(name, prefix)
+		}
+	}
+	return name
+}
+
+// declaredMethod returns the position of the named method of t if it's
+// declared outside generated files
+func declaredMethod(pkg *packages.Package, t types.Type, name string) (token.Position, bool) {
+	sel := types.NewMethodSet(types.NewPointer(t)).Lookup(pkg.Types, name)
+	if sel == nil {
+		return token.Position{}, false
+	}
+	pos := pkg.Fset.Position(sel.Obj().Pos())
+	for _, file := range pkg.Syntax {
+		if pkg.Fset.Position(file.Pos()).Filename == pos.Filename && ast.IsGenerated(file) {
+			return token.Position{}, false
+		}
+	}
+	return pos, true
+}
+
+// nameComment is the line comment naming a constant of a spec, and the
+// constant's place among those sharing it
+type nameComment struct {
+	group        *ast.CommentGroup
+	index, count int
+}
+
+// nameComments returns the line comment of each constant declared by a
+// spec. Usually that's the spec's comment, shared by all of them, but when
+// the names or values of a spec are spread over several lines with comments
+// between them, each constant takes the comment ending its own line, e.g.
+//
+//	Red, // red
+//	Green Color = 1, 2 // green
+func nameComments(fset *token.FileSet, file *ast.File, vspec *ast.ValueSpec) []nameComment {
+	shared := make([]nameComment, len(vspec.Names))
+	for i := range shared {
+		shared[i] = nameComment{group: vspec.Comment, index: i, count: len(shared)}
+	}
+
+	// The constants are placed by their names, or failing that their values
+	var nodes []ast.Node
+	line := func(n ast.Node) int { return fset.Position(n.Pos()).Line }
+	switch {
+	case line(vspec.Names[0]) != line(vspec.Names[len(vspec.Names)-1]):
+		for _, name := range vspec.Names {
+			nodes = append(nodes, name)
+		}
+	case len(vspec.Values) == len(vspec.Names) && line(vspec.Values[0]) != line(vspec.Values[len(vspec.Values)-1]):
+		for _, value := range vspec.Values {
+			nodes = append(nodes, value)
+		}
+	default:
+		return shared
+	}
+
+	// Group the constants by line, keeping the end of the last on each
+	lines := make(map[int][]int)
+	ends := make(map[int]token.Pos)
+	for i, n := range nodes {
+		l := line(n)
+		lines[l] = append(lines[l], i)
+		ends[l] = max(ends[l], n.End())
+	}
+	groups := make(map[int]*ast.CommentGroup)
+	for _, group := range file.Comments {
+		if group.Pos() < vspec.Pos() || (group.Pos() > vspec.End() && group != vspec.Comment) {
+			continue
+		}
+		if l := line(group); ends[l].IsValid() && group.Pos() > ends[l] && groups[l] == nil {
+			groups[l] = group
+		}
+	}
+
+	// With only the spec's own comment, it names every constant in turn
+	if len(groups) == 0 || (len(groups) == 1 && vspec.Comment != nil && groups[line(vspec.Comment)] == vspec.Comment) {
+		return shared
+	}
+	comments := make([]nameComment, len(vspec.Names))
+	for l, indexes := range lines {
+		for j, i := range indexes {
+			comments[i] = nameComment{group: groups[l], index: j, count: len(indexes)}
+		}
+	}
+	return comments
+}
+
+// generatedByEnumer reports whether a file is the output of a previous run
+func generatedByEnumer(file *ast.File) bool {
+	if !ast.IsGenerated(file) {
+		return false
+	}
+	for _, group := range file.Comments {
+		if group.Pos() > file.Package {
+			break
+		}
+		for _, c := range group.List {
+			if c.Text == "// Code generated by enumer; DO NOT EDIT." {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// kindName describes the kind of an underlying type for error messages
+func kindName(t types.Type) string {
+	switch t := t.(type) {
+	case *types.Basic:
+		return t.Name()
+	case *types.Struct:
+		return "struct"
+	case *types.Interface:
+		return "interface"
+	case *types.Pointer:
+		return "pointer"
+	case *types.Slice:
+		return "slice"
+	case *types.Array:
+		return "array"
+	case *types.Map:
+		return "map"
+	case *types.Chan:
+		return "chan"
+	case *types.Signature:
+		return "func"
+	default:
+		return t.String()
+	}
+}
+
+// isZero reports whether a constant is the zero value of its kind
+func isZero(val constant.Value) bool {
+	if val.Kind() == constant.String {
+		return constant.StringVal(val) == ""
+	}
+	return constant.Sign(val) == 0
+}
+
+// isSingleBit reports whether a constant value has exactly one bit set
+func isSingleBit(v constant.Value) bool {
+	if v.Kind() != constant.Int || constant.Sign(v) <= 0 {
+		return false
+	}
+	lower := constant.BinaryOp(v, token.SUB, constant.MakeInt64(1))
+	return constant.Sign(constant.BinaryOp(v, token.AND, lower)) == 0
+}
+
+// bitSize returns the size in bits of an integer type, as expected by
+// strconv.ParseInt; platform dependent types such as int return 0
+func bitSize(basic *types.Basic) int {
+	switch basic.Kind() {
+	case types.Int8, types.Uint8:
+		return 8
+	case types.Int16, types.Uint16:
+		return 16
+	case types.Int32, types.Uint32:
+		return 32
+	case types.Int64, types.Uint64:
+		return 64
+	}
+	return 0
+}
+
+// typeData is the data the templates shared between files are executed
+// against for each type
+type typeData struct {
+	TemplateData
+	Enum Enum
+}
+
+// parseTemplate parses the template text with the functions available to
+// all templates
+func parseTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("enumer").Funcs(template.FuncMap{
+		"parseKeys":   parseKeys,
+		"uniqueLower": uniqueLower,
+		"lower":       strings.ToLower,
+		"upper":       strings.ToUpper,
+		"quote":       strconv.Quote,
+		"typeData":    func(d TemplateData, e Enum) typeData { return typeData{d, e} },
+	}).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// generateCode executes the template and formats the result
+func generateCode(tmpl *template.Template, data TemplateData) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	// Format in memory, including the raw source on failure so template
+	// bugs can be diagnosed
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code: %w\n%s", err, buf.Bytes())
+	}
+
+	return src, nil
+}
+
+// parseKey is a string accepted when parsing and the constant it maps to
+type parseKey struct {
+	Key  string
+	Name string
+}
+
+// parseKeys returns every string accepted when parsing, sorted so the
+// lookup map reads alphabetically in the generated source
+func parseKeys(elements []Element) []parseKey {
+	var result []parseKey
+	for _, e := range elements {
+		for _, s := range append([]string{e.StringValue}, e.ParseNames...) {
+			result = append(result, parseKey{Key: s, Name: e.Name})
+		}
+	}
+	sort.Slice(result, func(a, b int) bool {
+		return result[a].Key < result[b].Key
+	})
+	return result
+}
+
+// uniqueLower returns the lowercased strings accepted when parsing, keeping
+// only the first for each key so the case-insensitive lookup map has no
+// duplicate keys
+func uniqueLower(elements []Element) []parseKey {
+	seen := make(map[string]bool)
+	var result []parseKey
+	for _, e := range elements {
+		for _, s := range append([]string{e.StringValue}, e.ParseNames...) {
+			lower := strings.ToLower(s)
+			if seen[lower] {
+				continue
+			}
+			seen[lower] = true
+			result = append(result, parseKey{Key: lower, Name: e.Name})
+		}
+	}
+	return result
+}
@@ -0,0 +1,579 @@
+package gen
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"golang.org/x/tools/go/packages"
+)
+
+func TestGenerate(t *testing.T) {
+	c := qt.New(t)
+
+	pkg := loadPackage(c, "simple_iota")
+	src, err := Generate(Config{
+		Package: pkg,
+		Types:   []string{"Status"},
+		Command: "enumer -type=Status -json",
+		Version: "v1.2.3",
+		Options: Options{JSON: JSONString},
+	})
+	c.Assert(err, qt.IsNil)
+
+	out := string(src)
+	c.Assert(out, qt.Contains, "// Command: enumer -type=Status -json\n// Version: v1.2.3\n")
+	c.Assert(out, qt.Contains, "package testpkg\n")
+	c.Assert(out, qt.Contains, "func StatusString(s string) (Status, error) {")
+	c.Assert(out, qt.Contains, "func (i Status) MarshalJSON() ([]byte, error) {")
+	c.Assert(out, qt.Not(qt.Contains), "MarshalYAML")
+
+	// Failures return the zero value declared for the kind
+	c.Assert(out, qt.Contains, "const _StatusZero Status = 0\n")
+	c.Assert(out, qt.Contains, "return _StatusZero, fmt.Errorf(")
+	src, err = Generate(Config{Package: loadPackage(c, "string_enum"), Types: []string{"Currency"}})
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(src), qt.Contains, `const _CurrencyZero Currency = ""`)
+
+	src, err = Generate(Config{Package: pkg, Types: []string{"Status"}, PackageName: "vendored"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(src), qt.Contains, "\npackage vendored\n")
+	c.Assert(string(src), qt.Not(qt.Contains), "// Version:")
+}
+
+func TestGenerateSortedNameMap(t *testing.T) {
+	c := qt.New(t)
+
+	src, err := Generate(Config{
+		Package: loadPackage(c, "comment_aliases"),
+		Types:   []string{"Color"},
+		Options: Options{LineComment: LineCommentOptional},
+	})
+	c.Assert(err, qt.IsNil)
+
+	// Keys are sorted, while the values slice keeps value order
+	out := string(src)
+	c.Assert(out, qt.Contains, `var _ColorNameToValueMap = map[string]Color{
+	"#ff0000": Red,
+	"Black":   Black,
+	"blue":    Blue,
+	"crimson": Red,
+	"gray":    Grey,
+	"green":   Green,
+	"grey":    Grey,
+	"lime":    Green,
+	"red":     Red,
+}`)
+	c.Assert(out, qt.Contains, "var _ColorValues = []Color{\n\tRed,\n\tGreen,\n\tBlue,\n\tGrey,\n\tBlack,\n}")
+}
+
+func TestGenerateEscapedStrings(t *testing.T) {
+	c := qt.New(t)
+
+	pkg := loadPackage(c, "escaped_strings")
+	for _, mode := range []string{ParseModeMap, ParseModeSwitch} {
+		src, err := Generate(Config{
+			Package: pkg,
+			Types:   []string{"Path", "Quote"},
+			Options: Options{LineComment: LineCommentStrict, CaseInsensitive: true, DocValues: true, ParseMode: mode},
+		})
+		c.Assert(err, qt.IsNil, qt.Commentf("parse mode %s", mode))
+
+		out := string(src)
+		c.Assert(out, qt.Contains, `"C:\\dir\\sub"`)
+		c.Assert(out, qt.Contains, `"it's a \\\"mix\\\""`)
+		c.Assert(out, qt.Contains, `"line\nbreak"`)
+	}
+}
+
+func TestGenerateDocValues(t *testing.T) {
+	c := qt.New(t)
+
+	src, err := Generate(Config{
+		Package: loadPackage(c, "simple_iota"),
+		Types:   []string{"Status"},
+		Options: Options{DocValues: true},
+	})
+	c.Assert(err, qt.IsNil)
+
+	out := string(src)
+	c.Assert(out, qt.Contains, "// StatusValues returns all values of the enum\n//\n// Valid values: Pending, Running, Success, Failure.\nfunc StatusValues()")
+	c.Assert(out, qt.Contains, "// Valid values: Pending, Running, Success, Failure.\nfunc StatusString(")
+	c.Assert(out, qt.Contains, "// Valid values: Pending, Running, Success, Failure.\nfunc (i Status) String()")
+
+	// Long lists are wrapped
+	enum, err := processType(loadPackage(c, "expressions"), "Size", Options{})
+	c.Assert(err, qt.IsNil)
+	c.Assert(enum.DocValues(), qt.DeepEquals, []string{
+		"Valid values: Small, Medium, Pair, Quad, Spread, KiB, Large, Huge, Top.",
+	})
+	enum.Elements = append(enum.Elements, enum.Elements...)
+	for _, line := range enum.DocValues() {
+		c.Assert(len(line) <= 72, qt.IsTrue, qt.Commentf("line %q", line))
+	}
+	c.Assert(enum.DocValues(), qt.HasLen, 2)
+}
+
+func TestGenerateComment(t *testing.T) {
+	c := qt.New(t)
+
+	src, err := Generate(Config{
+		Package: loadPackage(c, "simple_iota"),
+		Types:   []string{"Status"},
+		Command: "enumer -type=Status",
+		Options: Options{Comment: "Copyright 2024 Example Corp.\n\nLicensed under the Apache License.\n"},
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(src), qt.Contains, "// Command: enumer -type=Status\n\n// Copyright 2024 Example Corp.\n//\n// Licensed under the Apache License.\n\npackage testpkg\n")
+
+	// The banner mustn't hide the generated code marker, or become the
+	// package doc comment
+	file, err := parser.ParseFile(token.NewFileSet(), "status_enumer.go", src, parser.ParseComments)
+	c.Assert(err, qt.IsNil)
+	c.Assert(ast.IsGenerated(file), qt.IsTrue)
+	c.Assert(file.Doc, qt.IsNil)
+}
+
+func TestGeneratePlatform(t *testing.T) {
+	c := qt.New(t)
+
+	pkg := loadPackage(c, "platform_dependent")
+	_, err := Generate(Config{Package: pkg, Types: []string{"Size"}})
+	c.Assert(err, qt.ErrorMatches, `failed to process type Size: the value of Word depends on unsafe.Sizeof, .*`)
+	_, err = Generate(Config{Package: pkg, Types: []string{"Width"}})
+	c.Assert(err, qt.ErrorMatches, `failed to process type Width: the value of Native depends on the size of uint, .*`)
+
+	// Pinning the platform records it in the header
+	src, err := Generate(Config{Package: pkg, Types: []string{"Size", "Width"}, Command: "enumer -type=Size,Width", Platform: "linux/386"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(src), qt.Contains, "// Command: enumer -type=Size,Width\n// Platform: linux/386\n")
+
+	// Only when it changes the output
+	src, err = Generate(Config{Package: loadPackage(c, "simple_iota"), Types: []string{"Status"}, Platform: "linux/386"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(src), qt.Not(qt.Contains), "Platform")
+}
+
+func TestGenerateYAML(t *testing.T) {
+	c := qt.New(t)
+
+	cfg := Config{
+		Package: loadPackage(c, "simple_iota"),
+		Types:   []string{"Status"},
+		Options: Options{YAML: true, YAMLBuildTag: true, BuildTags: "linux || darwin"},
+	}
+	src, err := Generate(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(src), qt.Not(qt.Contains), "yaml")
+
+	src, err = GenerateYAML(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(src), qt.Matches, `(?s)//go:build yaml && \(linux \|\| darwin\)\n.*"gopkg.in/yaml.v3".*func \(i \*Status\) UnmarshalYAML\(node \*yaml.Node\) error.*`)
+
+	// The yaml.v2 form needs no import
+	cfg.YAMLVersion = 2
+	src, err = GenerateYAML(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(src), qt.Not(qt.Contains), "gopkg.in/yaml")
+	c.Assert(string(src), qt.Contains, "func (i *Status) UnmarshalYAML(unmarshal func(any) error) error")
+}
+
+func TestManifest(t *testing.T) {
+	c := qt.New(t)
+
+	manifest, err := Manifest(Config{
+		Package: loadPackage(c, "string_enum"),
+		Types:   []string{"Currency"},
+		Options: Options{Transform: "upper"},
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(manifest, qt.HasLen, 1)
+	c.Assert(manifest[0].Name, qt.Equals, "Currency")
+	c.Assert(manifest[0].Underlying, qt.Equals, "string")
+	c.Assert(manifest[0].Elements, qt.HasLen, 3)
+
+	// String based values are JSON strings
+	data, err := json.Marshal(manifest[0].Elements[0])
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(data), qt.Equals, `{"name":"EUR","value":"eur","string":"eur"}`)
+}
+
+func TestGenerateInterfaceAssertions(t *testing.T) {
+	c := qt.New(t)
+
+	pkg := loadPackage(c, "simple_iota")
+	tests := []struct {
+		opts Options
+		want []string
+	}{
+		{Options{}, []string{"_ fmt.Stringer = Status(0)"}},
+		{Options{JSON: JSONNumber}, []string{"_ json.Marshaler = Status(0)", "_ json.Unmarshaler = (*Status)(nil)"}},
+		{Options{Text: true, Binary: true}, []string{
+			"_ encoding.TextMarshaler = Status(0)", "_ encoding.TextUnmarshaler = (*Status)(nil)",
+			"_ encoding.BinaryMarshaler = Status(0)", "_ encoding.BinaryUnmarshaler = (*Status)(nil)",
+		}},
+		{Options{XML: true}, []string{"_ xml.Marshaler = Status(0)", "_ xml.UnmarshalerAttr = (*Status)(nil)"}},
+		{Options{YAML: true}, []string{"_ yaml.Marshaler = Status(0)", "_ yaml.Unmarshaler = (*Status)(nil)"}},
+		{Options{SQL: SQLInt, Nullable: true}, []string{
+			"_ sql.Scanner = (*Status)(nil)", "_ driver.Valuer = Status(0)",
+			"_ sql.Scanner = (*NullStatus)(nil)", "_ driver.Valuer = NullStatus{}",
+		}},
+		{Options{FlagValue: true}, []string{"_ flag.Value = (*Status)(nil)"}},
+	}
+
+	for _, tt := range tests {
+		src, err := Generate(Config{Package: pkg, Types: []string{"Status"}, Options: tt.opts})
+		c.Assert(err, qt.IsNil)
+		out := strings.Join(strings.Fields(string(src)), " ")
+		for _, want := range tt.want {
+			c.Assert(out, qt.Contains, want, qt.Commentf("%+v", tt.opts))
+		}
+	}
+
+	// Only the interfaces of enabled methods are asserted
+	src, err := Generate(Config{Package: pkg, Types: []string{"Status"}})
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(src), qt.Not(qt.Contains), "json.Marshaler")
+}
+
+func TestGenerateCustomTemplate(t *testing.T) {
+	c := qt.New(t)
+
+	pkg := loadPackage(c, "simple_iota")
+	src, err := Generate(Config{
+		Package: pkg,
+		Types:   []string{"Status"},
+		Template: `package {{.PackageName}}
+{{range .Types}}
+// custom marker for {{.Name}}
+func {{lower .Name}}Count() int { return {{len .Elements}} }
+{{end}}`,
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(src), qt.Equals, "package testpkg\n\n// custom marker for Status\nfunc statusCount() int { return 4 }\n")
+
+	_, err = Generate(Config{Package: pkg, Types: []string{"Missing"}, Template: "{{range}}"})
+	c.Assert(err, qt.ErrorMatches, `failed to parse template: .*missing value for range`)
+}
+
+func TestGenerateErrors(t *testing.T) {
+	c := qt.New(t)
+
+	pkg := loadPackage(c, "simple_iota")
+
+	_, err := Generate(Config{Package: pkg, Types: []string{"Missing"}})
+	c.Assert(err, qt.ErrorMatches, "failed to process type Missing: type Missing not found")
+
+	_, err = Generate(Config{Package: loadPackage(c, "invalid_kind"), Types: []string{"DefaultConfig"}})
+	c.Assert(err, qt.ErrorMatches, "failed to process type DefaultConfig: DefaultConfig is not a type")
+
+	_, err = Generate(Config{Package: loadPackage(c, "existing_string"), Types: []string{"Status"}, Options: Options{Existing: ExistingError}})
+	c.Assert(err, qt.ErrorMatches, `failed to process type Status: type Status already declares String at .*types.go:\d+:\d+; .*`)
+
+	_, err = Generate(Config{Package: loadPackage(c, "type_alias"), Types: []string{"State"}})
+	c.Assert(err, qt.ErrorMatches, "failed to process type State: State is an alias of Status; generate for that type instead")
+
+	_, err = Generate(Config{Package: pkg, Types: []string{"Status"}, PackageName: "not-valid"})
+	c.Assert(err, qt.ErrorMatches, `invalid package name "not-valid"`)
+
+	_, err = Generate(Config{Package: pkg, Types: []string{"Status"}, Options: Options{Transform: "shouty"}})
+	c.Assert(err, qt.ErrorMatches, `unknown transform "shouty"`)
+
+	_, err = Generate(Config{Package: pkg, Types: []string{"Status"}, Options: Options{IDPrefix: "1st"}})
+	c.Assert(err, qt.ErrorMatches, `invalid identifier prefix "1st"`)
+
+	_, err = Generate(Config{Package: pkg, Types: []string{"Status"}, Options: Options{BuildTags: "linux &&"}})
+	c.Assert(err, qt.ErrorMatches, `invalid build tags "linux &&": .*`)
+
+	_, err = Generate(Config{Package: pkg, Types: []string{"Status"}, Options: Options{YAMLBuildTag: true}})
+	c.Assert(err, qt.ErrorMatches, `a YAML build tag requires YAML methods`)
+
+	_, err = Generate(Config{Package: pkg, Types: []string{"Status"}, Options: Options{BitmaskBits: true}})
+	c.Assert(err, qt.ErrorMatches, `bitwise bitmask validation requires bitmask methods`)
+
+	_, err = Generate(Config{Package: pkg, Types: []string{"Status"}, Options: Options{InvalidFormat: "%d of %d"}})
+	c.Assert(err, qt.ErrorMatches, `invalid format "%d of %d" must have exactly one %d or %v verb for the value`)
+
+	_, err = Generate(Config{Package: pkg, Types: []string{"Status"}, Options: Options{Nullable: true}})
+	c.Assert(err, qt.ErrorMatches, `nullable types require SQL methods`)
+
+	_, err = Generate(Config{Package: pkg, Types: []string{"Status"}, Options: Options{Iter: true, Bitmask: true}})
+	c.Assert(err, qt.ErrorMatches, `iterators can't be generated with bitmask methods, .*`)
+
+	_, err = Generate(Config{Package: pkg, Types: []string{"Status"}, Options: Options{FlagValue: true, Bitmask: true}})
+	c.Assert(err, qt.ErrorMatches, `flag methods can't be generated with bitmask methods, .*`)
+
+	_, err = Generate(Config{Package: pkg, Types: []string{"Status"}, Options: Options{Exclude: "String,MarshalJSON"}})
+	c.Assert(err, qt.ErrorMatches, `"MarshalJSON" can't be excluded, must be one of AppendString, .*`)
+
+	_, err = Generate(Config{Package: pkg, Types: []string{"Status"}, Options: Options{ParseMode: "trie"}})
+	c.Assert(err, qt.ErrorMatches, `unknown parse mode "trie"`)
+
+	_, err = Generate(Config{Package: pkg, Types: []string{"Status"}, Options: Options{ParseMode: ParseModeSwitch, Bitmask: true}})
+	c.Assert(err, qt.ErrorMatches, `switch parse mode can't be used with bitmask methods, .*`)
+}
+
+func TestProcessType(t *testing.T) {
+	c := qt.New(t)
+
+	pkg := loadPackage(c, "composite")
+	enum, err := processType(pkg, "RunStatus", Options{})
+	c.Assert(err, qt.IsNil)
+
+	c.Assert(enum.Name, qt.Equals, "RunStatus")
+	c.Assert(enum.Underlying, qt.Equals, "int")
+	c.Assert(enum.Unsigned, qt.IsFalse)
+	c.Assert(enum.IsString, qt.IsFalse)
+
+	var names, values []string
+	var singleBits []bool
+	for _, e := range enum.Elements {
+		names = append(names, e.Name)
+		values = append(values, e.Value)
+		singleBits = append(singleBits, e.SingleBit)
+	}
+	c.Assert(names, qt.DeepEquals, []string{"Pending", "Running", "Success", "Failure", "Skipped", "Completed"})
+	c.Assert(values, qt.DeepEquals, []string{"1", "2", "4", "8", "16", "28"})
+	c.Assert(singleBits, qt.DeepEquals, []bool{true, true, true, true, true, false})
+}
+
+func TestProcessTypeExpressions(t *testing.T) {
+	c := qt.New(t)
+
+	pkg := loadPackage(c, "expressions")
+	enum, err := processType(pkg, "Size", Options{Bitmask: true})
+	c.Assert(err, qt.IsNil)
+
+	values := make(map[string]string)
+	singleBits := make(map[string]bool)
+	for _, e := range enum.Elements {
+		values[e.Name] = e.Value
+		singleBits[e.Name] = e.SingleBit
+	}
+	c.Assert(values, qt.DeepEquals, map[string]string{
+		"Small": "1", "Medium": "2", "Quad": "4", "KiB": "1024", "Large": "1048576",
+		"Huge": "1099511627776", "Top": "9223372036854775808", "Pair": "3", "Spread": "10",
+	})
+	c.Assert(singleBits, qt.DeepEquals, map[string]bool{
+		"Small": true, "Medium": true, "Quad": true, "KiB": true, "Large": true,
+		"Huge": true, "Top": true, "Pair": false, "Spread": false,
+	})
+}
+
+func TestProcessTypeDense(t *testing.T) {
+	c := qt.New(t)
+
+	tests := []struct {
+		pkg      string
+		typeName string
+		opts     Options
+		dense    bool
+		base     int64
+	}{
+		{"simple_iota", "Status", Options{}, true, 0},
+		{"dense", "Weekday", Options{}, true, 1},
+		{"with_gaps", "Priority", Options{}, false, 0},
+		{"negative", "Result", Options{}, false, 0},
+		{"string_enum", "Currency", Options{}, false, 0},
+		{"flags", "Permission", Options{Bitmask: true}, false, 0},
+		{"protobuf_style", "Foo", Options{}, true, 0},
+		{"protobuf_style", "Bar", Options{}, false, 0},
+	}
+
+	for _, tt := range tests {
+		enum, err := processType(loadPackage(c, tt.pkg), tt.typeName, tt.opts)
+		c.Assert(err, qt.IsNil)
+		c.Assert(enum.Dense, qt.Equals, tt.dense, qt.Commentf("%s.%s", tt.pkg, tt.typeName))
+		c.Assert(enum.Base, qt.Equals, tt.base, qt.Commentf("%s.%s", tt.pkg, tt.typeName))
+	}
+
+	enum, err := processType(loadPackage(c, "dense"), "Weekday", Options{LineComment: LineCommentOptional})
+	c.Assert(err, qt.IsNil)
+	c.Assert(enum.NameIndex(), qt.DeepEquals, []int{0, 6, 13, 16, 24, 30, 38, 44})
+}
+
+func TestProcessTypeStringValues(t *testing.T) {
+	c := qt.New(t)
+
+	pkg := loadPackage(c, "trim_suffix")
+	enum, err := processType(pkg, "Status", Options{
+		TrimPrefix:  "Status",
+		TrimSuffix:  "State",
+		Transform:   "upper",
+		AddPrefix:   "s.",
+		LineComment: LineCommentOptional,
+	})
+	c.Assert(err, qt.IsNil)
+
+	var strs []string
+	for _, e := range enum.Elements {
+		strs = append(strs, e.StringValue)
+	}
+	c.Assert(strs, qt.DeepEquals, []string{"s.ACTIVE", "s.CLOSED", "s.paused", "s.ARCHIVED"})
+}
+
+func TestEnumQuoteJSON(t *testing.T) {
+	c := qt.New(t)
+
+	tests := []struct {
+		enum Enum
+		want bool
+	}{
+		{Enum{Name: "Status", Elements: []Element{{StringValue: "Pending"}, {StringValue: "in progress"}}}, true},
+		{Enum{Name: "Status", Elements: []Element{{StringValue: `say "hi"\`}}}, true},
+		{Enum{Name: "Status", Elements: []Element{{StringValue: "a<b"}}}, false},
+		{Enum{Name: "Status", Elements: []Element{{StringValue: "R&D"}}}, false},
+		{Enum{Name: "Status", Elements: []Element{{StringValue: "café"}}}, false},
+		{Enum{Name: "Status", Elements: []Element{{StringValue: "tab\t"}}}, false},
+		{Enum{Name: "Statüs", Elements: []Element{{StringValue: "Pending"}}}, false},
+		{Enum{Name: "Currency", IsString: true}, false},
+		{Enum{Name: "Status", HasString: true}, false},
+	}
+
+	for _, tt := range tests {
+		c.Assert(tt.enum.QuoteJSON(), qt.Equals, tt.want, qt.Commentf("%+v", tt.enum))
+	}
+}
+
+func TestTrimPrefixes(t *testing.T) {
+	c := qt.New(t)
+
+	tests := []struct {
+		name     string
+		prefixes string
+		expected string
+	}{
+		{"StatusActive", "", "StatusActive"},
+		{"StatusActive", "Status", "Active"},
+		{"StateActive", "Status,State", "Active"},
+		{"StatusOldActive", "Status,StatusOld", "Active"},
+		{"StatusOldActive", "StatusOld,Status", "Active"},
+		{"Unknown", "Status,State", "Unknown"},
+		{"StatusActive", "Status,,", "Active"},
+	}
+
+	for _, tt := range tests {
+		c.Assert(trimPrefixes(tt.name, tt.prefixes), qt.Equals, tt.expected, qt.Commentf("trimming %q from %q", tt.prefixes, tt.name))
+	}
+}
+
+func TestIsDeprecated(t *testing.T) {
+	c := qt.New(t)
+
+	tests := []struct {
+		doc      string
+		expected bool
+	}{
+		{"", false},
+		{"Deprecated: use Running.", true},
+		{"Started is the old name of Running.\n\nDeprecated: use Running.", true},
+		{"Failed is no longer reported. Deprecated: use Done.", false},
+		{"Deprecated values are kept for parsing", false},
+	}
+
+	for _, tt := range tests {
+		c.Assert(isDeprecated(tt.doc), qt.Equals, tt.expected, qt.Commentf("doc %q", tt.doc))
+	}
+}
+
+func TestSplitInvalidFormat(t *testing.T) {
+	c := qt.New(t)
+
+	tests := []struct {
+		format, prefix, suffix string
+		err                    string
+	}{
+		{"Status(%d)", "Status(", ")", ""},
+		{"<invalid %v>", "<invalid ", ">", ""},
+		{"%d", "", "", ""},
+		{"%d%%", "", "%", ""},
+		{"100%% %d", "100% ", "", ""},
+		{"unknown", "", "", `invalid format "unknown" must have exactly one %d or %v verb for the value`},
+		{"%d%d", "", "", `invalid format "%d%d" must have exactly one %d or %v verb for the value`},
+		{"%s", "", "", `invalid format "%s" can only use the %d or %v verb, or %% for a literal %`},
+		{"%x(%d)", "", "", `invalid format "%x\(%d\)" can only use .*`},
+		{"Status(%d)%", "", "", `invalid format "Status\(%d\)%" can only use .*`},
+	}
+
+	for _, tt := range tests {
+		prefix, suffix, err := splitInvalidFormat(tt.format)
+		if tt.err != "" {
+			c.Assert(err, qt.ErrorMatches, tt.err, qt.Commentf("format %q", tt.format))
+			continue
+		}
+		c.Assert(err, qt.IsNil, qt.Commentf("format %q", tt.format))
+		c.Assert(prefix, qt.Equals, tt.prefix, qt.Commentf("format %q", tt.format))
+		c.Assert(suffix, qt.Equals, tt.suffix, qt.Commentf("format %q", tt.format))
+	}
+}
+
+func TestCommentNames(t *testing.T) {
+	c := qt.New(t)
+
+	tests := []struct {
+		comment  string
+		val      constant.Value
+		expected []string
+		err      string
+	}{
+		{"red, crimson", constant.MakeInt64(2), []string{"red", "crimson"}, ""},
+		{"code-%d, c%v", constant.MakeInt64(2), []string{"code-2", "c2"}, ""},
+		{"%d%%", constant.MakeInt64(50), []string{"50%"}, ""},
+		{"100%% sure", constant.MakeInt64(2), []string{"100%% sure"}, ""},
+		{"a-%d-%d", constant.MakeInt64(2), nil, `invalid format "a-%d-%d" must have exactly one %d or %v verb for the value`},
+		{"%d%s", constant.MakeInt64(2), nil, `invalid format "%d%s" can only use .*`},
+	}
+
+	for _, tt := range tests {
+		names, err := commentNames(tt.comment, tt.val)
+		if tt.err != "" {
+			c.Assert(err, qt.ErrorMatches, tt.err, qt.Commentf("comment %q", tt.comment))
+			continue
+		}
+		c.Assert(err, qt.IsNil, qt.Commentf("comment %q", tt.comment))
+		c.Assert(names, qt.DeepEquals, tt.expected, qt.Commentf("comment %q", tt.comment))
+	}
+}
+
+func TestSplitWords(t *testing.T) {
+	c := qt.New(t)
+
+	tests := []struct {
+		name     string
+		expected []string
+	}{
+		{"Status", []string{"Status"}},
+		{"RunStatus", []string{"Run", "Status"}},
+		{"HTTPRequestFailed", []string{"HTTP", "Request", "Failed"}},
+		{"userID", []string{"user", "ID"}},
+		{"Foo_BAR", []string{"Foo", "BAR"}},
+		{"kebab-case", []string{"kebab", "case"}},
+		{"Status2XX", []string{"Status2", "XX"}},
+	}
+
+	for _, tt := range tests {
+		c.Assert(splitWords(tt.name), qt.DeepEquals, tt.expected, qt.Commentf("splitting %q", tt.name))
+	}
+}
+
+// loadPackage loads one of the packages under testdata
+func loadPackage(c *qt.C, name string) *packages.Package {
+	c.Helper()
+
+	cfg := &packages.Config{
+		Mode: LoadMode,
+		Dir:  filepath.Join("..", "testdata", name),
+	}
+	pkgs, err := packages.Load(cfg, ".")
+	c.Assert(err, qt.IsNil)
+	c.Assert(pkgs, qt.HasLen, 1)
+	c.Assert(pkgs[0].Errors, qt.HasLen, 0, qt.Commentf("errors: %v", pkgs[0].Errors))
+
+	return pkgs[0]
+}
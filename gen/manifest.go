@@ -0,0 +1,61 @@
+package gen
+
+import (
+	"encoding/json"
+	"go/constant"
+)
+
+// ManifestEnum describes an enum for tools outside Go, such as
+// documentation generators, that need its values without parsing the
+// generated code
+type ManifestEnum struct {
+	Name       string            `json:"name"`
+	Package    string            `json:"package"`
+	Underlying string            `json:"underlying"`
+	Elements   []ManifestElement `json:"elements"`
+}
+
+// ManifestElement describes a constant of an enum. Value is a JSON number
+// for integer enums and a string for string based ones
+type ManifestElement struct {
+	Name   string          `json:"name"`
+	Value  json.RawMessage `json:"value"`
+	String string          `json:"string"`
+	Alias  bool            `json:"alias,omitempty"`
+}
+
+// Manifest describes the configured types as they would be generated, with
+// the same options applied to their strings
+func Manifest(cfg Config) ([]ManifestEnum, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	data, err := templateData(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := make([]ManifestEnum, 0, len(data.Types))
+	for _, enum := range data.Types {
+		m := ManifestEnum{
+			Name:       enum.Name,
+			Package:    cfg.Package.PkgPath,
+			Underlying: enum.Underlying,
+			Elements:   make([]ManifestElement, 0, len(enum.Elements)),
+		}
+		for _, e := range enum.Elements {
+			value := json.RawMessage(e.val.ExactString())
+			if e.val.Kind() == constant.String {
+				value, _ = json.Marshal(constant.StringVal(e.val))
+			}
+			m.Elements = append(m.Elements, ManifestElement{
+				Name:   e.Name,
+				Value:  value,
+				String: e.StringValue,
+				Alias:  e.Alias,
+			})
+		}
+		manifest = append(manifest, m)
+	}
+	return manifest, nil
+}
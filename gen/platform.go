@@ -0,0 +1,124 @@
+package gen
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// platformConsts are the standard library constants whose values depend on
+// the GOOS or GOARCH the package is built for
+var platformConsts = map[string]bool{
+	"runtime.GOOS":    true,
+	"runtime.GOARCH":  true,
+	"math.MaxInt":     true,
+	"math.MinInt":     true,
+	"math.MaxUint":    true,
+	"strconv.IntSize": true,
+}
+
+// platformChecker finds constants whose values depend on the platform the
+// package was loaded for, as the generated file would differ between them
+type platformChecker struct {
+	pkg   *packages.Package
+	exprs map[*types.Const]ast.Expr // value expression of each constant in the package
+	seen  map[*types.Const]string
+}
+
+func newPlatformChecker(pkg *packages.Package) *platformChecker {
+	c := &platformChecker{
+		pkg:   pkg,
+		exprs: make(map[*types.Const]ast.Expr),
+		seen:  make(map[*types.Const]string),
+	}
+	for _, file := range pkg.Syntax {
+		for _, decl := range file.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.CONST {
+				continue
+			}
+
+			// Specs without values repeat the previous expressions
+			var values []ast.Expr
+			for _, spec := range gd.Specs {
+				vspec := spec.(*ast.ValueSpec)
+				if len(vspec.Values) > 0 {
+					values = vspec.Values
+				}
+				for i, name := range vspec.Names {
+					if obj, ok := pkg.TypesInfo.Defs[name].(*types.Const); ok && i < len(values) {
+						c.exprs[obj] = values[i]
+					}
+				}
+			}
+		}
+	}
+	return c
+}
+
+// dependence returns what makes the constant's value depend on the
+// platform, e.g. unsafe.Sizeof, or "" if nothing does
+func (c *platformChecker) dependence(obj *types.Const) string {
+	if reason, ok := c.seen[obj]; ok {
+		return reason
+	}
+	// Guard against cycles while the expression is checked
+	c.seen[obj] = ""
+	reason := c.exprDependence(c.exprs[obj])
+	c.seen[obj] = reason
+	return reason
+}
+
+func (c *platformChecker) exprDependence(expr ast.Expr) string {
+	if expr == nil {
+		return ""
+	}
+	var reason string
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if reason != "" {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			// unsafe.Sizeof and friends measure the platform's types
+			if b, ok := c.pkg.TypesInfo.Uses[calledIdent(n.Fun)].(*types.Builtin); ok && b.Pkg() == types.Unsafe {
+				reason = "unsafe." + b.Name()
+			}
+		case *ast.UnaryExpr:
+			// Complementing a uint or uintptr depends on its size
+			if n.Op == token.XOR {
+				if basic, ok := c.pkg.TypesInfo.TypeOf(n.X).Underlying().(*types.Basic); ok && (basic.Kind() == types.Uint || basic.Kind() == types.Uintptr) {
+					reason = "the size of " + basic.Name()
+				}
+			}
+		case *ast.Ident:
+			obj, ok := c.pkg.TypesInfo.Uses[n].(*types.Const)
+			if !ok || obj.Pkg() == nil {
+				break
+			}
+			if name := obj.Pkg().Path() + "." + obj.Name(); platformConsts[name] {
+				reason = name
+			} else if obj.Pkg() == c.pkg.Types {
+				reason = c.dependence(obj)
+			}
+		}
+		return true
+	})
+	return reason
+}
+
+// calledIdent returns the identifier naming the function of a call, e.g.
+// Sizeof for unsafe.Sizeof
+func calledIdent(fun ast.Expr) *ast.Ident {
+	switch fun := fun.(type) {
+	case *ast.Ident:
+		return fun
+	case *ast.SelectorExpr:
+		return fun.Sel
+	case *ast.ParenExpr:
+		return calledIdent(fun.X)
+	}
+	return nil
+}
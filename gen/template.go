@@ -0,0 +1,1307 @@
+package gen
+
+const codeTemplate = `
+{{- if .BuildTags}}//go:build {{.BuildTags}}
+{{range .PlusBuildLines}}{{.}}
+{{end}}
+{{end -}}
+// Code generated by enumer; DO NOT EDIT.
+// See: https://github.com/spaceweasel/enumer
+// Command: {{.Command}}
+{{- if .Version}}
+// Version: {{.Version}}
+{{- end}}
+{{- if .Platform}}
+// Platform: {{.Platform}}
+{{- end}}
+{{- if .CommentLines}}
+{{range .CommentLines}}
+{{.}}
+{{- end}}
+{{- end}}
+
+package {{.PackageName}}
+
+import (
+	"errors"
+{{- if .SQL}}
+	"database/sql"
+	"database/sql/driver"
+{{- end}}
+{{- if or .Text .Binary}}
+	"encoding"
+{{- end}}
+{{- if .FlagValue}}
+	"flag"
+{{- end}}
+	"fmt"
+{{- if .GQL}}
+	"io"
+{{- end}}
+{{- if or (and (or .ParseNumber .ParsePlaceholder) .HasIntegerTypes) (eq .SQL "int") .QuotesJSON .AppendsNumbers}}
+	"strconv"
+{{- end}}
+{{- if or .CaseInsensitive .Bitmask .TrimSpace (and .ParsePlaceholder .HasIntegerTypes)}}
+	"strings"
+{{- end}}
+{{- if .JSON}}
+	"encoding/json"
+{{- end}}
+{{- if .XML}}
+	"encoding/xml"
+{{- end}}
+{{- if and .YAML (ne .YAMLVersion 2)}}
+	"gopkg.in/yaml.v3"
+{{- end}}
+{{- if .Registry}}
+	"github.com/spaceweasel/enumer/enumregistry"
+{{- end}}
+)
+
+{{range $enum := .Types}}
+{{$typeName := $enum.Name}}
+{{- /* Generated identifiers are prefixed, but interface methods aren't */}}
+{{$idPrefix := $.IDPrefix}}
+{{$id := print $idPrefix $typeName}}
+{{$errInvalid := print $idPrefix "ErrInvalid" $typeName}}
+{{$nullType := print $idPrefix "Null" $typeName}}
+{{$elements := $enum.Elements}}
+{{$zero := "0"}}{{if $enum.IsString}}{{$zero = "\"\""}}{{end}}
+{{$trimPrefix := $.TrimPrefix}}
+{{$switch := eq $.ParseMode "switch"}}
+{{$excluded := $.Excluded}}
+
+// {{$errInvalid}} is wrapped by the errors returned when a string or value
+// isn't a valid {{$typeName}}
+var {{$errInvalid}} = errors.New("not a valid {{$typeName}}")
+
+// _{{$id}}Zero is the {{$typeName}} returned when a string or value isn't valid
+const _{{$id}}Zero {{$typeName}} = {{$zero}}
+
+{{if not $switch}}
+var _{{$id}}Map = map[{{$typeName}}]string{
+{{- range $elements}}{{if not .Alias}}
+	{{.Name}}: {{printf "%q" .StringValue}},
+{{- end}}{{end}}
+}
+{{end}}
+
+{{if $enum.Dense}}
+const _{{$id}}Name = {{printf "%q" $enum.NameString}}
+
+var _{{$id}}Index = [...]uint16{ {{- range $i, $offset := $enum.NameIndex}}{{if $i}}, {{end}}{{$offset}}{{end -}} }
+{{end}}
+var _{{$id}}Values = []{{$typeName}}{
+{{- range $elements}}{{if not .Alias}}
+	{{.Name}},
+{{- end}}{{end}}
+}
+
+var _{{$id}}Names = []string{
+{{- range $elements}}{{if not .Alias}}
+	{{printf "%q" .StringValue}},
+{{- end}}{{end}}
+}
+
+{{if $.Bitmask}}
+var _{{$id}}Flags = []{{$typeName}}{
+{{- range $enum.Flags}}
+	{{.Name}},
+{{- end}}
+}
+
+{{$first := true -}}
+const _{{$id}}AllBits {{$typeName}} = {{range $elements}}{{if and .SingleBit (not .Alias)}}{{if not $first}} | {{end}}{{.Name}}{{$first = false}}{{end}}{{end}}{{if $first}}0{{end}}
+{{end}}
+{{- if $switch}}
+// _{{$id}}FromName returns the value of a string accepted by {{$id}}String
+func _{{$id}}FromName(s string) ({{$typeName}}, bool) {
+	switch s {
+{{- range parseKeys $elements}}
+	case {{printf "%q" .Key}}:
+		return {{.Name}}, true
+{{- end}}
+	}
+	return _{{$id}}Zero, false
+}
+{{if $.CaseInsensitive}}
+// _{{$id}}FromLowerName returns the value of a lower case string accepted
+// by {{$id}}String
+func _{{$id}}FromLowerName(s string) ({{$typeName}}, bool) {
+	switch s {
+{{- range uniqueLower $elements}}
+	case {{printf "%q" .Key}}:
+		return {{.Name}}, true
+{{- end}}
+	}
+	return _{{$id}}Zero, false
+}
+{{end}}
+{{- else}}
+var _{{$id}}NameToValueMap = map[string]{{$typeName}}{
+{{- range parseKeys $elements}}
+	{{printf "%q" .Key}}: {{.Name}},
+{{- end}}
+}
+{{if $.CaseInsensitive}}
+var _{{$id}}LowerNameToValueMap = map[string]{{$typeName}}{
+{{- range uniqueLower $elements}}
+	{{printf "%q" .Key}}: {{.Name}},
+{{- end}}
+}
+{{end}}
+{{- end}}
+{{- if $.Labels}}
+var _{{$id}}LabelMap = map[{{$typeName}}]string{
+{{- range $elements}}{{if not .Alias}}
+	{{.Name}}: {{printf "%q" .Label}},
+{{- end}}{{end}}
+}
+{{end}}
+{{- if $.Descriptions}}
+var _{{$id}}DescriptionMap = map[{{$typeName}}]string{
+{{- range $elements}}{{if and (not .Alias) .Description}}
+	{{.Name}}: {{printf "%q" .Description}},
+{{- end}}{{end}}
+}
+{{end}}
+
+{{if and $.Bitmask (not $enum.HasString)}}
+{{- if not (index $excluded "String")}}
+// String returns the string representation of the {{$typeName}} value, joining
+// the names of the set flags with "|" when it isn't a named constant
+{{- if $.DocValues}}
+//
+{{- range $enum.DocValues}}
+// {{.}}
+{{- end}}
+{{- end}}
+func (i {{$typeName}}) String() string {
+	if str, ok := _{{$id}}Map[i]; ok {
+		return str
+	}
+	var buf [64]byte
+	return string(i.AppendString(buf[:0]))
+}
+{{end}}
+{{- if not (index $excluded "AppendString")}}
+// AppendString appends the string representation of the {{$typeName}}
+// value to b, as String returns it, and returns the extended buffer
+func (i {{$typeName}}) AppendString(b []byte) []byte {
+	if str, ok := _{{$id}}Map[i]; ok {
+		return append(b, str...)
+	}
+	n := len(b)
+	remaining := i
+	for _, flag := range _{{$id}}Flags {
+		if remaining&flag != 0 {
+			if len(b) > n {
+				b = append(b, '|')
+			}
+			b = append(b, _{{$id}}Map[flag]...)
+			remaining &^= flag
+		}
+	}
+	if remaining != 0 || len(b) == n {
+		if len(b) > n {
+			b = append(b, '|')
+		}
+{{- if $enum.InvalidPrefix}}
+		b = append(b, {{printf "%q" $enum.InvalidPrefix}}...)
+{{- end}}
+		b = strconv.Append{{if $enum.Unsigned}}Uint(b, uint64(remaining){{else}}Int(b, int64(remaining){{end}}, 10)
+{{- if $enum.InvalidSuffix}}
+		b = append(b, {{printf "%q" $enum.InvalidSuffix}}...)
+{{- end}}
+	}
+	return b
+}
+{{end}}
+{{- else if not $enum.HasString}}
+{{- if not (index $excluded "String")}}
+// String returns the string representation of the {{$typeName}} value
+{{- if $.DocValues}}
+//
+{{- range $enum.DocValues}}
+// {{.}}
+{{- end}}
+{{- end}}
+func (i {{$typeName}}) String() string {
+{{- if $enum.IsString}}
+	return string(i)
+{{- else}}
+	if str, ok := _{{$id}}Lookup(i); ok {
+		return str
+	}
+	var buf [64]byte
+	return string(i.AppendString(buf[:0]))
+{{- end}}
+}
+{{end}}
+{{- if not $enum.IsString}}
+// _{{$id}}Lookup returns the string of a named {{$typeName}} value
+func _{{$id}}Lookup(i {{$typeName}}) (string, bool) {
+{{- if $enum.Dense}}
+	if v := int64(i){{if $enum.Base}} - {{$enum.Base}}{{end}}; v >= 0 && v < int64(len(_{{$id}}Index)-1) {
+		return _{{$id}}Name[_{{$id}}Index[v]:_{{$id}}Index[v+1]], true
+	}
+	return "", false
+{{- else if $switch}}
+	switch i {
+{{- range $elements}}{{if not .Alias}}
+	case {{.Name}}:
+		return {{printf "%q" .StringValue}}, true
+{{- end}}{{end}}
+	}
+	return "", false
+{{- else}}
+	str, ok := _{{$id}}Map[i]
+	return str, ok
+{{- end}}
+}
+{{- end}}
+{{end}}
+
+{{- if and (or (not $.Bitmask) $enum.HasString) (not (index $excluded "AppendString"))}}
+// AppendString appends the string representation of the {{$typeName}}
+// value to b, as String returns it, and returns the extended buffer
+func (i {{$typeName}}) AppendString(b []byte) []byte {
+{{- if $enum.IsString}}
+	return append(b, i...)
+{{- else if $enum.HasString}}
+	return append(b, i.String()...)
+{{- else}}
+	if str, ok := _{{$id}}Lookup(i); ok {
+		return append(b, str...)
+	}
+{{- if $enum.InvalidPrefix}}
+	b = append(b, {{printf "%q" $enum.InvalidPrefix}}...)
+{{- end}}
+{{- if $enum.InvalidSuffix}}
+	b = strconv.Append{{if $enum.Unsigned}}Uint(b, uint64(i){{else}}Int(b, int64(i){{end}}, 10)
+	return append(b, {{printf "%q" $enum.InvalidSuffix}}...)
+{{- else}}
+	return strconv.Append{{if $enum.Unsigned}}Uint(b, uint64(i){{else}}Int(b, int64(i){{end}}, 10)
+{{- end}}
+{{- end}}
+}
+{{- end}}
+
+{{if not (index $excluded "Values")}}
+// {{$id}}Values returns all values of the enum
+{{- if $.DocValues}}
+//
+{{- range $enum.DocValues}}
+// {{.}}
+{{- end}}
+{{- end}}
+func {{$id}}Values() []{{$typeName}} {
+	return _{{$id}}Values
+}
+{{end}}
+{{- if $.Deprecation}}
+var _{{$id}}ActiveValues = []{{$typeName}}{
+{{- range $enum.ActiveValues}}
+	{{.Name}},
+{{- end}}
+}
+
+// {{$id}}ActiveValues returns the values of the enum that aren't
+// deprecated, in the order of {{$id}}Values. Deprecated values still parse
+func {{$id}}ActiveValues() []{{$typeName}} {
+	return _{{$id}}ActiveValues
+}
+{{end}}
+{{if not (index $excluded "ValuesMap")}}
+// {{$id}}ValuesMap returns a new map of every string accepted by
+// {{$id}}String to its value, which the caller is free to modify
+func {{$id}}ValuesMap() map[string]{{$typeName}} {
+{{- if $switch}}
+	return map[string]{{$typeName}}{
+{{- range parseKeys $elements}}
+		{{printf "%q" .Key}}: {{.Name}},
+{{- end}}
+	}
+{{- else}}
+	m := make(map[string]{{$typeName}}, len(_{{$id}}NameToValueMap))
+	for k, v := range _{{$id}}NameToValueMap {
+		m[k] = v
+	}
+	return m
+{{- end}}
+}
+{{end}}
+{{if not (index $excluded "Min")}}
+// {{$id}}Min returns the smallest {{$typeName}} value
+func {{$id}}Min() {{$typeName}} {
+	return _{{$id}}Values[0]
+}
+{{end}}
+{{- if not (index $excluded "Max")}}
+// {{$id}}Max returns the largest {{$typeName}} value
+func {{$id}}Max() {{$typeName}} {
+	return _{{$id}}Values[len(_{{$id}}Values)-1]
+}
+{{end}}
+{{- if not (index $excluded "Len")}}
+// {{$id}}Len returns the number of distinct {{$typeName}} values
+func {{$id}}Len() int {
+	return len(_{{$id}}Values)
+}
+{{end}}
+{{- if not (index $excluded "Names")}}
+// {{$id}}Names returns the string representations of all values of the enum
+func {{$id}}Names() []string {
+	return _{{$id}}Names
+}
+{{end}}
+{{- if not (index $excluded "Entries")}}
+// {{$id}}Entry pairs a {{$typeName}} value with its string representation
+type {{$id}}Entry struct {
+	Name  string
+	Value {{$typeName}}
+}
+
+var _{{$id}}Entries = []{{$id}}Entry{
+{{- range $elements}}{{if not .Alias}}
+	{ {{- printf "%q" .StringValue}}, {{.Name -}} },
+{{- end}}{{end}}
+}
+
+// {{$id}}Entries returns the name and value of every value of the enum, in
+// the order of {{$id}}Values
+func {{$id}}Entries() []{{$id}}Entry {
+	return _{{$id}}Entries
+}
+{{end}}
+
+{{if $.Iter}}
+// {{$id}}All yields all values of the enum in order. It is an
+// iter.Seq[{{$typeName}}], so can be ranged over with Go 1.23 or later
+func {{$id}}All(yield func({{$typeName}}) bool) {
+	for _, v := range _{{$id}}Values {
+		if !yield(v) {
+			return
+		}
+	}
+}
+{{end}}
+
+// {{$id}}String retrieves an enum value from the string representation
+{{- if $.Bitmask}}, which may
+// be several flag names joined with "|"
+{{- end}}
+{{- if $.StrictZero}}
+//
+// No {{$typeName}} constant is zero, so the zero value returned on failure
+// can't be mistaken for a valid value
+{{- end}}
+{{- if $.DocValues}}
+//
+{{- range $enum.DocValues}}
+// {{.}}
+{{- end}}
+{{- end}}
+func {{$id}}String(s string) ({{$typeName}}, error) {
+{{- if $.TrimSpace}}
+	s = strings.TrimSpace(s)
+{{- end}}
+{{- if $switch}}
+	if val, ok := _{{$id}}FromName(s); ok {
+		return val, nil
+	}
+{{- if $.CaseInsensitive}}
+	if val, ok := _{{$id}}FromLowerName(strings.ToLower(s)); ok {
+		return val, nil
+	}
+{{- end}}
+{{- else}}
+	if val, ok := _{{$id}}NameToValueMap[s]; ok {
+		return val, nil
+	}
+{{- if $.CaseInsensitive}}
+	if val, ok := _{{$id}}LowerNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+{{- end}}
+{{- end}}
+{{- if and $.ParseNumber (not $enum.IsString)}}
+{{- if $enum.Unsigned}}
+	if n, err := strconv.ParseUint(s, 10, {{$enum.Bits}}); err == nil {
+{{- else}}
+	if n, err := strconv.ParseInt(s, 10, {{$enum.Bits}}); err == nil {
+{{- end}}
+{{- if $switch}}
+		if val := {{$typeName}}(n); val.Valid() {
+			return val, nil
+		}
+{{- else}}
+		if _, ok := _{{$id}}Map[{{$typeName}}(n)]; ok {
+			return {{$typeName}}(n), nil
+		}
+{{- end}}
+	}
+{{- end}}
+{{- if and $.ParsePlaceholder (not $enum.IsString)}}
+	// Accept the form String gives values without a name, so String and
+	// {{$id}}String round trip for every value
+	if inner, ok := strings.CutPrefix(s, {{printf "%q" $enum.InvalidPrefix}}); ok {
+		if inner, ok := strings.CutSuffix(inner, {{printf "%q" $enum.InvalidSuffix}}); ok {
+{{- if $enum.Unsigned}}
+			if n, err := strconv.ParseUint(inner, 10, {{$enum.Bits}}); err == nil {
+{{- else}}
+			if n, err := strconv.ParseInt(inner, 10, {{$enum.Bits}}); err == nil {
+{{- end}}
+				return {{$typeName}}(n), nil
+			}
+		}
+	}
+{{- end}}
+{{- if and $.Bitmask $enum.EmptySet}}
+	// No flags parses in the form String gives it, so zero values round trip
+	if s == {{printf "%q" $enum.EmptySet}} {
+		return 0, nil
+	}
+{{- end}}
+{{- if $.Bitmask}}
+	if strings.Contains(s, "|") {
+		var result {{$typeName}}
+		for _, part := range strings.Split(s, "|") {
+			val, err := {{$id}}String(strings.TrimSpace(part))
+			if err != nil {
+				return _{{$id}}Zero, err
+			}
+			result |= val
+		}
+		return result, nil
+	}
+{{- end}}
+	return _{{$id}}Zero, fmt.Errorf("%s is %w", s, {{$errInvalid}})
+}
+
+// {{$idPrefix}}IsValid{{$typeName}}Name reports whether {{$id}}String accepts s,
+// without building an error when it doesn't
+func {{$idPrefix}}IsValid{{$typeName}}Name(s string) bool {
+{{- if $.TrimSpace}}
+	s = strings.TrimSpace(s)
+{{- end}}
+{{- if $switch}}
+	if _, ok := _{{$id}}FromName(s); ok {
+		return true
+	}
+{{- if $.CaseInsensitive}}
+	if _, ok := _{{$id}}FromLowerName(strings.ToLower(s)); ok {
+		return true
+	}
+{{- end}}
+{{- else}}
+	if _, ok := _{{$id}}NameToValueMap[s]; ok {
+		return true
+	}
+{{- if $.CaseInsensitive}}
+	if _, ok := _{{$id}}LowerNameToValueMap[strings.ToLower(s)]; ok {
+		return true
+	}
+{{- end}}
+{{- end}}
+{{- if or (and (or $.ParseNumber $.ParsePlaceholder) (not $enum.IsString)) $.Bitmask}}
+	_, err := {{$id}}String(s)
+	return err == nil
+{{- else}}
+	return false
+{{- end}}
+}
+
+// {{$idPrefix}}Must{{$typeName}}String retrieves an enum value from the string representation, panicking if it isn't valid
+func {{$idPrefix}}Must{{$typeName}}String(s string) {{$typeName}} {
+	val, err := {{$id}}String(s)
+	if err != nil {
+		panic(err)
+	}
+	return val
+}
+{{if $.WithDefault}}
+// {{$id}}OrDefault retrieves an enum value from the string representation, returning def if it isn't valid
+func {{$id}}OrDefault(s string, def {{$typeName}}) {{$typeName}} {
+	if val, err := {{$id}}String(s); err == nil {
+		return val
+	}
+	return def
+}
+{{end}}
+{{if $.Normalize}}
+// {{$idPrefix}}Normalize{{$typeName}} parses s as {{$id}}String does and returns
+// the value's String form, so accepted spellings of a name become one
+func {{$idPrefix}}Normalize{{$typeName}}(s string) (string, error) {
+	val, err := {{$id}}String(s)
+	if err != nil {
+		return "", err
+	}
+	return val.String(), nil
+}
+{{end}}
+{{if $.ValidateFunc}}
+// {{$idPrefix}}Validate{{$typeName}} returns an error for the first of the values that isn't valid, or nil if they all are
+func {{$idPrefix}}Validate{{$typeName}}(values ...{{$typeName}}) error {
+	for _, v := range values {
+		if !v.Valid() {
+			return fmt.Errorf("{{if $enum.IsString}}%q{{else}}%d{{end}} is %w", {{$enum.Underlying}}(v), {{$errInvalid}})
+		}
+	}
+	return nil
+}
+{{end}}
+{{if $.CSV}}
+// {{$id}}SliceToStrings returns the string representation of each value, e.g. for a CSV record
+func {{$id}}SliceToStrings(values []{{$typeName}}) []string {
+	strs := make([]string, len(values))
+	for i, v := range values {
+		strs[i] = v.String()
+	}
+	return strs
+}
+
+// {{$id}}SliceFromStrings parses each string, e.g. from a CSV record, failing on the first that isn't valid
+func {{$id}}SliceFromStrings(strs []string) ([]{{$typeName}}, error) {
+	values := make([]{{$typeName}}, len(strs))
+	for i, s := range strs {
+		val, err := {{$id}}String(s)
+		if err != nil {
+			return nil, fmt.Errorf("index %d: %w", i, err)
+		}
+		values[i] = val
+	}
+	return values, nil
+}
+{{end}}
+{{- if $.Batch}}
+// {{$id}}Strings parses each string, returning an error joining one for
+// each that isn't valid, which gives its index. The values are returned
+// either way, with the zero value at the index of each failure
+func {{$id}}Strings(ss []string) ([]{{$typeName}}, error) {
+	values := make([]{{$typeName}}, len(ss))
+	var errs []error
+	for i, s := range ss {
+		val, err := {{$id}}String(s)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("index %d: %w", i, err))
+		}
+		values[i] = val
+	}
+	return values, errors.Join(errs...)
+}
+{{end}}
+{{if $.SetType}}
+// {{$id}}Set is a set of {{$typeName}} values
+type {{$id}}Set map[{{$typeName}}]struct{}
+
+// {{$idPrefix}}New{{$typeName}}Set returns a set containing the values
+func {{$idPrefix}}New{{$typeName}}Set(values ...{{$typeName}}) {{$id}}Set {
+	s := make({{$id}}Set, len(values))
+	s.Add(values...)
+	return s
+}
+
+// Add adds the values to the set
+func (s {{$id}}Set) Add(values ...{{$typeName}}) {
+	for _, v := range values {
+		s[v] = struct{}{}
+	}
+}
+
+// Remove removes the values from the set
+func (s {{$id}}Set) Remove(values ...{{$typeName}}) {
+	for _, v := range values {
+		delete(s, v)
+	}
+}
+
+// Contains returns true if the value is in the set
+func (s {{$id}}Set) Contains(v {{$typeName}}) bool {
+	_, ok := s[v]
+	return ok
+}
+
+// Slice returns the values in the set in the order of {{$id}}Values.
+// Values that aren't valid are left out
+func (s {{$id}}Set) Slice() []{{$typeName}} {
+	values := make([]{{$typeName}}, 0, len(s))
+	for _, v := range _{{$id}}Values {
+		if _, ok := s[v]; ok {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+{{end}}
+// {{$id}}FromValue retrieves an enum value from its underlying value
+func {{$id}}FromValue(v {{$enum.Underlying}}) ({{$typeName}}, error) {
+	if val := {{$typeName}}(v); val.Valid() {
+		return val, nil
+	}
+	return _{{$id}}Zero, fmt.Errorf("{{if $enum.IsString}}%q{{else}}%d{{end}} is %w", v, {{$errInvalid}})
+}
+
+{{if index $excluded "Valid"}}
+{{- else if and $.Bitmask $.BitmaskBits}}
+// Valid returns true if the value is a named {{$typeName}} constant, or a
+// combination of its flags
+func (i {{$typeName}}) Valid() bool {
+	if _, ok := _{{$id}}Map[i]; ok {
+		return true
+	}
+	return i&^_{{$id}}AllBits == 0
+}
+{{else}}
+// Valid returns true if the value is a valid {{$typeName}}
+func (i {{$typeName}}) Valid() bool {
+{{- if $switch}}
+	switch i {
+	{{- $first := true}}
+	case {{range $elements}}{{if not .Alias}}{{if not $first}},
+		{{end}}{{.Name}}{{$first = false}}{{end}}{{end}}:
+		return true
+	}
+	return false
+{{- else}}
+	_, ok := _{{$id}}Map[i]
+	return ok
+{{- end}}
+}
+{{end}}
+
+{{if $.Navigation}}
+// Next returns the {{$typeName}} value following i in {{$id}}Values,
+// and false if i is the last value or isn't valid
+func (i {{$typeName}}) Next() ({{$typeName}}, bool) {
+	for n, v := range _{{$id}}Values {
+		if v == i && n+1 < len(_{{$id}}Values) {
+			return _{{$id}}Values[n+1], true
+		}
+	}
+	return i, false
+}
+
+// Prev returns the {{$typeName}} value preceding i in {{$id}}Values,
+// and false if i is the first value or isn't valid
+func (i {{$typeName}}) Prev() ({{$typeName}}, bool) {
+	for n, v := range _{{$id}}Values {
+		if v == i && n > 0 {
+			return _{{$id}}Values[n-1], true
+		}
+	}
+	return i, false
+}
+{{end}}
+
+{{if $.Labels}}
+// Label returns a human friendly form of the {{$typeName}} constant's name,
+// with spaces between its words, falling back to String
+func (i {{$typeName}}) Label() string {
+	if label, ok := _{{$id}}LabelMap[i]; ok {
+		return label
+	}
+	return i.String()
+}
+{{end}}
+
+{{if $.GoString}}
+// GoString returns the name of the {{$typeName}} constant as declared, for
+// printing with %#v, or a conversion of the value if it has no name
+func (i {{$typeName}}) GoString() string {
+	switch i {
+{{- range $elements}}{{if not .Alias}}
+	case {{.Name}}:
+		return "{{.Name}}"
+{{- end}}{{end}}
+	}
+	return fmt.Sprintf("{{$typeName}}({{if $enum.IsString}}%q{{else}}%d{{end}})", {{if $enum.IsString}}string(i){{else if $enum.Unsigned}}uint64(i){{else}}int64(i){{end}})
+}
+{{end}}
+
+{{if $.Descriptions}}
+// Description returns the doc comment of the {{$typeName}} constant, or an
+// empty string if it has none
+func (i {{$typeName}}) Description() string {
+	return _{{$id}}DescriptionMap[i]
+}
+{{end}}
+
+{{if $.Bitmask}}
+// {{$id}}All returns all of the {{$typeName}} flags combined. Named
+// composites are made up of these, so don't contribute any further bits
+func {{$id}}All() {{$typeName}} {
+	return _{{$id}}AllBits
+}
+
+// Flags returns the individual flags set in the {{$typeName}} value, in
+// declaration order. Bits that don't belong to a flag are ignored
+func (i {{$typeName}}) Flags() []{{$typeName}} {
+	var flags []{{$typeName}}
+	for _, flag := range _{{$id}}Flags {
+		if i&flag != 0 {
+			flags = append(flags, flag)
+		}
+	}
+	return flags
+}
+
+// Has returns true if the flag is set in the {{$typeName}} value
+func (i {{$typeName}}) Has(flag {{$typeName}}) bool {
+	return i&flag != 0
+}
+
+// HasAny returns true if any of the provided flags are set in the {{$typeName}} value
+func (i {{$typeName}}) HasAny(flags ...{{$typeName}}) bool {
+	for _, flag := range flags {
+		if i&flag != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAll returns true if all of the provided flags are set in the {{$typeName}} value
+func (i {{$typeName}}) HasAll(flags ...{{$typeName}}) bool {
+	for _, flag := range flags {
+		if i&flag == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Set returns a new {{$typeName}} with the specified flags set
+func (i {{$typeName}}) Set(flags ...{{$typeName}}) {{$typeName}} {
+	result := i
+	for _, flag := range flags {
+		result |= flag
+	}
+	return result
+}
+
+// Clear returns a new {{$typeName}} with the specified flags cleared
+func (i {{$typeName}}) Clear(flags ...{{$typeName}}) {{$typeName}} {
+	result := i
+	for _, flag := range flags {
+		result &^= flag
+	}
+	return result
+}
+
+// Toggle returns a new {{$typeName}} with the specified flags toggled
+func (i {{$typeName}}) Toggle(flags ...{{$typeName}}) {{$typeName}} {
+	result := i
+	for _, flag := range flags {
+		result ^= flag
+	}
+	return result
+}
+{{end}}
+
+{{if eq $.JSON "number"}}
+// MarshalJSON implements the json.Marshaler interface for {{$typeName}},
+// using the underlying number
+func (i {{$typeName}}) MarshalJSON() ([]byte, error) {
+	return json.Marshal({{$enum.Underlying}}(i))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for {{$typeName}},
+// accepting either the underlying number or the string representation
+func (i *{{$typeName}}) UnmarshalJSON(data []byte) error {
+	var n {{$enum.Underlying}}
+	if err := json.Unmarshal(data, &n); err == nil {
+		val, err := {{$id}}FromValue(n)
+		if err != nil {
+			return err
+		}
+		*i = val
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("{{$typeName}} should be a number or a string, got %s: %w", data, {{$errInvalid}})
+	}
+
+	val, err := {{$id}}String(s)
+	if err != nil {
+		return err
+	}
+	*i = val
+	return nil
+}
+{{else if eq $.JSON "preserve"}}
+// MarshalJSON implements the json.Marshaler interface for {{$typeName}},
+// using the underlying number for values that aren't valid so they survive
+// a round trip
+func (i {{$typeName}}) MarshalJSON() ([]byte, error) {
+	if !i.Valid() {
+		return json.Marshal({{$enum.Underlying}}(i))
+	}
+{{- if $enum.QuoteJSON}}
+	return strconv.AppendQuote(nil, i.String()), nil
+{{- else}}
+	return json.Marshal(i.String())
+{{- end}}
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for {{$typeName}},
+// accepting the string representation, or any number as it is
+func (i *{{$typeName}}) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		val, err := {{$id}}String(s)
+		if err != nil {
+			return err
+		}
+		*i = val
+		return nil
+	}
+
+	var n {{$enum.Underlying}}
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("{{$typeName}} should be a string or a number, got %s: %w", data, {{$errInvalid}})
+	}
+	*i = {{$typeName}}(n)
+	return nil
+}
+{{else if $.JSON}}
+// MarshalJSON implements the json.Marshaler interface for {{$typeName}}
+func (i {{$typeName}}) MarshalJSON() ([]byte, error) {
+{{- if $enum.QuoteJSON}}
+	return strconv.AppendQuote(nil, i.String()), nil
+{{- else}}
+	return json.Marshal(i.String())
+{{- end}}
+}
+
+{{if eq $.JSON "lenient" -}}
+// UnmarshalJSON implements the json.Unmarshaler interface for {{$typeName}},
+// accepting the underlying number when the value isn't a string
+func (i *{{$typeName}}) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		val, err := {{$id}}String(s)
+		if err != nil {
+			return err
+		}
+		*i = val
+		return nil
+	}
+
+	var n {{$enum.Underlying}}
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("{{$typeName}} should be a string or a number, got %s: %w", data, {{$errInvalid}})
+	}
+
+	val, err := {{$id}}FromValue(n)
+	if err != nil {
+		return err
+	}
+	*i = val
+	return nil
+}
+{{- else -}}
+// UnmarshalJSON implements the json.Unmarshaler interface for {{$typeName}}
+func (i *{{$typeName}}) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("{{$typeName}} should be a string, got %s: %w", data, {{$errInvalid}})
+	}
+
+	val, err := {{$id}}String(s)
+	if err != nil {
+		return err
+	}
+	*i = val
+	return nil
+}
+{{- end}}
+{{end}}
+
+{{if $.YAML}}
+{{template "yaml" typeData $ $enum}}
+{{end}}
+
+{{if $.Text}}
+// MarshalText implements the encoding.TextMarshaler interface for {{$typeName}}
+func (i {{$typeName}}) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for {{$typeName}}
+func (i *{{$typeName}}) UnmarshalText(text []byte) error {
+	val, err := {{$id}}String(string(text))
+	if err != nil {
+		return err
+	}
+	*i = val
+	return nil
+}
+{{end}}
+
+{{if $.FlagValue}}
+// Set implements the flag.Value interface for {{$typeName}}, so it can be
+// used as a command line flag. The value is left unchanged on error
+func (i *{{$typeName}}) Set(s string) error {
+	val, err := {{$id}}String(s)
+	if err != nil {
+		return err
+	}
+	*i = val
+	return nil
+}
+
+// Type returns the name of the type, as required by pflag.Value
+func (i *{{$typeName}}) Type() string {
+	return "{{$typeName}}"
+}
+{{end}}
+
+{{if $.Binary}}
+// MarshalBinary implements the encoding.BinaryMarshaler interface for
+// {{$typeName}}. The string representation is used rather than the number,
+// so encoded data stays valid if the constants are renumbered
+func (i {{$typeName}}) MarshalBinary() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for {{$typeName}}
+func (i *{{$typeName}}) UnmarshalBinary(data []byte) error {
+	val, err := {{$id}}String(string(data))
+	if err != nil {
+		return err
+	}
+	*i = val
+	return nil
+}
+{{end}}
+
+{{if $.GQL}}
+// MarshalGQL implements the graphql.Marshaler interface for {{$typeName}}
+func (i {{$typeName}}) MarshalGQL(w io.Writer) {
+	fmt.Fprintf(w, "%q", i.String())
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface for {{$typeName}}
+func (i *{{$typeName}}) UnmarshalGQL(v any) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("{{$typeName}} should be a string, got %T: %w", v, {{$errInvalid}})
+	}
+
+	val, err := {{$id}}String(s)
+	if err != nil {
+		return err
+	}
+	*i = val
+	return nil
+}
+{{end}}
+
+{{if $.XML}}
+// MarshalXML implements the xml.Marshaler interface for {{$typeName}}
+func (i {{$typeName}}) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(i.String(), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for {{$typeName}}
+func (i *{{$typeName}}) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return fmt.Errorf("{{$typeName}} should be a string: %w", err)
+	}
+
+	val, err := {{$id}}String(s)
+	if err != nil {
+		return err
+	}
+	*i = val
+	return nil
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface for {{$typeName}}
+func (i {{$typeName}}) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: i.String()}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface for {{$typeName}}
+func (i *{{$typeName}}) UnmarshalXMLAttr(attr xml.Attr) error {
+	val, err := {{$id}}String(attr.Value)
+	if err != nil {
+		return err
+	}
+	*i = val
+	return nil
+}
+{{end}}
+
+{{if eq $.SQL "int"}}
+// Scan implements the sql.Scanner interface for {{$typeName}}, reading
+// the underlying number
+func (i *{{$typeName}}) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	var n int64
+	switch v := value.(type) {
+	case int64:
+		n = v
+	case int:
+		n = int64(v)
+	case []byte:
+		var err error
+		if n, err = strconv.ParseInt(string(v), 10, 64); err != nil {
+			return fmt.Errorf("cannot scan %q into {{$typeName}}: %w", v, {{$errInvalid}})
+		}
+	case string:
+		var err error
+		if n, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return fmt.Errorf("cannot scan %q into {{$typeName}}: %w", v, {{$errInvalid}})
+		}
+	default:
+		return fmt.Errorf("cannot scan type %T into {{$typeName}}: %w", value, {{$errInvalid}})
+	}
+
+	// Reject numbers that don't fit rather than letting them wrap around
+	val := {{$typeName}}(n)
+	if int64(val) != n || !val.Valid() {
+		return fmt.Errorf("cannot scan %d into {{$typeName}}: %w", n, {{$errInvalid}})
+	}
+	*i = val
+	return nil
+}
+
+// Value implements the driver.Valuer interface for {{$typeName}}, storing
+// the underlying number
+func (i {{$typeName}}) Value() (driver.Value, error) {
+	return int64(i), nil
+}
+{{else if $.SQL}}
+// Scan implements the sql.Scanner interface for {{$typeName}}
+func (i *{{$typeName}}) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	var val {{$typeName}}
+	switch v := value.(type) {
+	case string:
+		var err error
+		if val, err = {{$id}}String(v); err != nil {
+			return err
+		}
+	case []byte:
+		var err error
+		if val, err = {{$id}}String(string(v)); err != nil {
+			return err
+		}
+	{{- if not $enum.IsString}}
+	case int:
+		return i.Scan(int64(v))
+	case int64:
+		// Some drivers return integers for columns that once held numbers
+		val = {{$typeName}}(v)
+		if int64(val) != v {
+			return fmt.Errorf("cannot scan %d into {{$typeName}}: %w", v, {{$errInvalid}})
+		}
+	{{- end}}
+	default:
+		return fmt.Errorf("cannot scan type %T into {{$typeName}}: %w", value, {{$errInvalid}})
+	}
+
+	// Check the value however it was parsed, so nothing invalid is stored
+	if !val.Valid() {
+		return fmt.Errorf("cannot scan %v into {{$typeName}}: %w", value, {{$errInvalid}})
+	}
+	*i = val
+	return nil
+}
+
+// Value implements the driver.Valuer interface for {{$typeName}}
+func (i {{$typeName}}) Value() (driver.Value, error) {
+	return i.String(), nil
+}
+{{end}}
+
+{{if $.Nullable}}
+// {{$nullType}} represents a {{$typeName}} that may be null, for use
+// with nullable columns in the same way as sql.NullString
+type {{$nullType}} struct {
+	{{$typeName}} {{$typeName}}
+	Valid bool // Valid is true if {{$typeName}} is not NULL
+}
+
+// Scan implements the sql.Scanner interface for {{$nullType}}
+func (n *{{$nullType}}) Scan(value any) error {
+	if value == nil {
+		n.{{$typeName}}, n.Valid = _{{$id}}Zero, false
+		return nil
+	}
+	if err := n.{{$typeName}}.Scan(value); err != nil {
+		n.Valid = false
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver.Valuer interface for {{$nullType}}
+func (n {{$nullType}}) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.{{$typeName}}.Value()
+}
+{{end}}
+
+{{if $.Registry}}
+func init() {
+	err := enumregistry.Register({{printf "%q" (print $.PkgPath "." $typeName)}}, func(s string) (int64, error) {
+		val, err := {{$id}}String(s)
+		return int64(val), err
+	}, func(n int64) (string, error) {
+		if val := {{$typeName}}(n); int64(val) == n && val.Valid() {
+			return val.String(), nil
+		}
+		return "", fmt.Errorf("%d is %w", n, {{$errInvalid}})
+	})
+	if err != nil {
+		// Another copy of the package, e.g. a vendored one, registered first
+		panic(err)
+	}
+}
+{{end}}
+
+// Check that {{$typeName}} implements the interfaces its methods are for, so
+// a change of signature fails to compile
+var (
+	_ fmt.Stringer = {{$typeName}}({{$zero}})
+{{- if $.JSON}}
+	_ json.Marshaler = {{$typeName}}({{$zero}})
+	_ json.Unmarshaler = (*{{$typeName}})(nil)
+{{- end}}
+{{- if $.Text}}
+	_ encoding.TextMarshaler = {{$typeName}}({{$zero}})
+	_ encoding.TextUnmarshaler = (*{{$typeName}})(nil)
+{{- end}}
+{{- if $.Binary}}
+	_ encoding.BinaryMarshaler = {{$typeName}}({{$zero}})
+	_ encoding.BinaryUnmarshaler = (*{{$typeName}})(nil)
+{{- end}}
+{{- if $.XML}}
+	_ xml.Marshaler = {{$typeName}}({{$zero}})
+	_ xml.Unmarshaler = (*{{$typeName}})(nil)
+	_ xml.MarshalerAttr = {{$typeName}}({{$zero}})
+	_ xml.UnmarshalerAttr = (*{{$typeName}})(nil)
+{{- end}}
+{{- if and $.YAML (ne $.YAMLVersion 2)}}
+	_ yaml.Marshaler = {{$typeName}}({{$zero}})
+	_ yaml.Unmarshaler = (*{{$typeName}})(nil)
+{{- end}}
+{{- if $.SQL}}
+	_ sql.Scanner = (*{{$typeName}})(nil)
+	_ driver.Valuer = {{$typeName}}({{$zero}})
+{{- end}}
+{{- if $.Nullable}}
+	_ sql.Scanner = (*{{$nullType}})(nil)
+	_ driver.Valuer = {{$nullType}}{}
+{{- end}}
+{{- if $.FlagValue}}
+	_ flag.Value = (*{{$typeName}})(nil)
+{{- end}}
+)
+
+{{end}}
+`
+
+// yamlTemplate defines the YAML methods of a type, executed against
+// typeData. The built-in templates share it, so the methods are the same
+// whether or not they're generated in a separate file
+const yamlTemplate = `
+{{- define "yaml"}}
+{{- $typeName := .Enum.Name}}
+{{- $id := print .IDPrefix $typeName}}
+{{- $errInvalid := print .IDPrefix "ErrInvalid" $typeName}}
+// MarshalYAML implements the yaml.Marshaler interface for {{$typeName}}
+func (i {{$typeName}}) MarshalYAML() (any, error) {
+	return i.String(), nil
+}
+
+{{if eq .YAMLVersion 2 -}}
+// UnmarshalYAML implements the yaml.Unmarshaler interface for {{$typeName}}
+func (i *{{$typeName}}) UnmarshalYAML(unmarshal func(any) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return fmt.Errorf("{{$typeName}} should be a string: %w: %w", err, {{$errInvalid}})
+	}
+
+	val, err := {{$id}}String(s)
+	if err != nil {
+		return err
+	}
+	*i = val
+	return nil
+}
+{{- else -}}
+// UnmarshalYAML implements the yaml.Unmarshaler interface for {{$typeName}}
+func (i *{{$typeName}}) UnmarshalYAML(node *yaml.Node) error {
+	var s string
+	if err := node.Decode(&s); err != nil {
+		return fmt.Errorf("{{$typeName}} should be a string, got %v: %w", node.Value, {{$errInvalid}})
+	}
+
+	val, err := {{$id}}String(s)
+	if err != nil {
+		return err
+	}
+	*i = val
+	return nil
+}
+{{- end}}
+{{- end}}`
+
+// yamlFileTemplate is the file of YAML methods generated by GenerateYAML
+const yamlFileTemplate = `//go:build {{.BuildTags}}
+{{range .PlusBuildLines}}{{.}}
+{{end}}
+// Code generated by enumer; DO NOT EDIT.
+// See: https://github.com/spaceweasel/enumer
+// Command: {{.Command}}
+{{- if .Version}}
+// Version: {{.Version}}
+{{- end}}
+{{- if .Platform}}
+// Platform: {{.Platform}}
+{{- end}}
+{{- if .CommentLines}}
+{{range .CommentLines}}
+{{.}}
+{{- end}}
+{{- end}}
+
+package {{.PackageName}}
+
+import (
+	"fmt"
+{{- if ne .YAMLVersion 2}}
+
+	"gopkg.in/yaml.v3"
+{{- end}}
+)
+
+{{range $enum := .Types}}
+{{template "yaml" typeData $ $enum}}
+{{- if ne $.YAMLVersion 2}}
+
+// Check that {{$enum.Name}} implements the YAML interfaces, so a change of
+// signature fails to compile
+var (
+	_ yaml.Marshaler = {{$enum.Name}}({{if $enum.IsString}}""{{else}}0{{end}})
+	_ yaml.Unmarshaler = (*{{$enum.Name}})(nil)
+)
+{{- end}}
+{{end}}
+`
@@ -0,0 +1,95 @@
+package gen
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// transforms maps each -transform mode to the function applied to a name
+var transforms = map[string]func(string) string{
+	"snake":  func(s string) string { return strings.ToLower(strings.Join(splitWords(s), "_")) },
+	"kebab":  func(s string) string { return strings.ToLower(strings.Join(splitWords(s), "-")) },
+	"lower":  strings.ToLower,
+	"upper":  strings.ToUpper,
+	"camel":  toCamel,
+	"pascal": toPascal,
+}
+
+// transformName applies the named transform to a constant name
+func transformName(name, mode string) (string, error) {
+	if mode == "" {
+		return name, nil
+	}
+	fn, ok := transforms[mode]
+	if !ok {
+		return "", fmt.Errorf("unknown transform %q", mode)
+	}
+	return fn(name), nil
+}
+
+// splitWords splits a name into words at camelCase boundaries, underscores
+// and hyphens. A run of capitals is kept together as an acronym, so
+// "HTTPRequest" becomes "HTTP", "Request".
+func splitWords(s string) []string {
+	var words []string
+	var current []rune
+
+	runes := []rune(s)
+	for i, r := range runes {
+		if r == '_' || r == '-' {
+			if len(current) > 0 {
+				words = append(words, string(current))
+				current = nil
+			}
+			continue
+		}
+
+		if len(current) > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if !unicode.IsUpper(prev) || nextIsLower {
+				words = append(words, string(current))
+				current = nil
+			}
+		}
+		current = append(current, r)
+	}
+	if len(current) > 0 {
+		words = append(words, string(current))
+	}
+
+	return words
+}
+
+// capitalize upper cases the first letter of a word and lower cases the rest
+func capitalize(word string) string {
+	runes := []rune(strings.ToLower(word))
+	if len(runes) > 0 {
+		runes[0] = unicode.ToUpper(runes[0])
+	}
+	return string(runes)
+}
+
+// toPascal converts a name to PascalCase
+func toPascal(s string) string {
+	var b strings.Builder
+	for _, word := range splitWords(s) {
+		b.WriteString(capitalize(word))
+	}
+	return b.String()
+}
+
+// toCamel converts a name to camelCase
+func toCamel(s string) string {
+	words := splitWords(s)
+	var b strings.Builder
+	for i, word := range words {
+		if i == 0 {
+			b.WriteString(strings.ToLower(word))
+			continue
+		}
+		b.WriteString(capitalize(word))
+	}
+	return b.String()
+}
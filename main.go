@@ -2,440 +2,568 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"go/ast"
+	"go/parser"
 	"go/token"
-	"go/types"
 	"log"
 	"os"
-	"os/exec"
+	"path/filepath"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 
 	"golang.org/x/tools/go/packages"
+
+	"github.com/spaceweasel/enumer/gen"
 )
 
 var (
-	typeNames   = flag.String("type", "", "comma-separated list of type names; must be set")
-	output      = flag.String("output", "", "output file name; default is <type>_enumer.go for single type")
-	trimPrefix  = flag.String("trimprefix", "", "prefix to be trimmed from the name of each constant")
-	lineComment = flag.Bool("linecomment", false, "use line comment text as printed text when present")
-	sqlFlag     = flag.Bool("sql", false, "enable SQL Scanner and Valuer interface generation")
-	jsonFlag    = flag.Bool("json", false, "enable JSON marshaling methods")
-	yamlFlag    = flag.Bool("yaml", false, "enable YAML marshaling methods")
-	bitmaskFlag = flag.Bool("bitmask", false, "enable bitmask methods for flag based enums")
+	typeNames        = newListFlag("type", "comma-separated `list` of type names, which can be repeated; must be set")
+	output           = flag.String("output", "", "output file name, or - for stdout; default is <type>_enumer.go for single type")
+	manifestPath     = flag.String("manifest", "", "`path` of a JSON file to also write, describing each type's constants for tools outside Go")
+	fileTemplate     = flag.String("filetemplate", "", "`pattern` of the default output file name, with {{.Type}} replaced by the lowercased type name, e.g. enum_{{.Type}}.gen.go")
+	trimPrefix       = flag.String("trimprefix", "", "comma-separated list of prefixes to be trimmed from the name of each constant")
+	trimSuffix       = flag.String("trimsuffix", "", "suffix to be trimmed from the name of each constant")
+	addPrefix        = flag.String("addprefix", "", "prefix to be added to the string representation of each constant")
+	transform        = flag.String("transform", "", "transform applied to each trimmed name: snake, kebab, lower, upper, camel or pascal")
+	tags             = flag.String("tags", "", "comma-separated list of build tags to apply when loading the package")
+	buildTags        = flag.String("buildtags", "", "build constraint expression added to the generated file as a //go:build line")
+	comment          = flag.String("comment", "", "text of a comment block, such as a license banner, added below the generated code header")
+	goFile           = flag.String("gofile", "", "only use constants declared in this file of the package, e.g. $GOFILE under go:generate")
+	lineComment      = newModeFlag("linecomment", "use line comment text as printed text, failing if only some constants have one; optional falls back to their names, and both parses the names too", gen.LineCommentStrict, gen.LineCommentOptional, gen.LineCommentBoth)
+	sqlFlag          = newModeFlag("sql", "enable SQL Scanner and Valuer interface generation, storing strings or integers", gen.SQLText, gen.SQLInt)
+	nullable         = flag.Bool("nullable", false, "also generate a NullFoo type for nullable SQL columns; requires -sql")
+	jsonFlag         = newModeFlag("json", "enable JSON marshaling methods, as strings or as the underlying number; lenient marshals strings but also accepts numbers; preserve is lenient but keeps unknown numbers", gen.JSONString, gen.JSONNumber, gen.JSONLenient, gen.JSONPreserve)
+	yamlFlag         = newModeFlag("yaml", "enable YAML marshaling methods; buildtag puts them in a separate _yaml.go file built with the yaml tag, so other builds don't need gopkg.in/yaml", "inline", "buildtag")
+	yamlVersion      = flag.Int("yamlversion", 3, "major version of gopkg.in/yaml targeted by -yaml: 2 or 3")
+	textFlag         = flag.Bool("text", false, "enable encoding.TextMarshaler and TextUnmarshaler methods")
+	flagValue        = flag.Bool("flag", false, "enable flag.Value (and pflag.Value) methods so the enum can be used as a command line flag")
+	binaryFlag       = flag.Bool("binary", false, "enable encoding.BinaryMarshaler and BinaryUnmarshaler methods")
+	gqlFlag          = flag.Bool("gql", false, "enable gqlgen MarshalGQL and UnmarshalGQL methods")
+	xmlFlag          = flag.Bool("xml", false, "enable XML marshaling methods")
+	bitmaskFlag      = newModeFlag("bitmask", "enable bitmask methods for flag based enums; Valid accepts only the named constants, or with bits any combination of the flags' bits", "named", "bits")
+	caseInsensitive  = flag.Bool("caseinsensitive", false, "fall back to case-insensitive matching when parsing strings")
+	parseNumber      = flag.Bool("parsenumber", false, "fall back to parsing the numeric value when parsing strings")
+	parsePlaceholder = flag.Bool("parseplaceholder", false, "also parse the form String gives values without a name, Foo(%d) unless -invalidformat is set, returning the value whether or not it's valid")
+	trimSpace        = flag.Bool("trimspace", false, "trim surrounding whitespace from strings before parsing")
+	descriptions     = flag.Bool("descriptions", false, "generate a Description method returning each constant's doc comment")
+	deprecation      = flag.Bool("deprecation", false, "generate FooActiveValues, leaving out constants whose doc comment has a Deprecated: paragraph")
+	docValues        = flag.Bool("docvalues", false, "list the valid strings in the doc comments of FooValues, FooString and String")
+	goString         = flag.Bool("gostring", false, "generate a GoString method returning each constant's name, for printing with %#v")
+	labels           = flag.Bool("labels", false, "generate a Label method returning each trimmed name with spaces between its words")
+	iterFlag         = flag.Bool("iter", false, "generate a FooAll iterator for range over func; requires Go 1.23")
+	navigation       = flag.Bool("navigation", false, "generate Next and Prev methods to step through the values in order")
+	withDefault      = flag.Bool("withdefault", false, "generate FooOrDefault, returning a fallback value when a string can't be parsed")
+	normalize        = flag.Bool("normalize", false, "generate NormalizeFoo, parsing a string and returning the value's String form")
+	validateFunc     = flag.Bool("validate", false, "generate ValidateFoo, returning an error for the first of several values that isn't valid")
+	csvFlag          = flag.Bool("csv", false, "generate FooSliceToStrings and FooSliceFromStrings for converting CSV records")
+	batch            = flag.Bool("batch", false, "generate FooStrings, parsing a slice of strings and reporting every one that isn't valid")
+	setType          = flag.Bool("set", false, "generate a FooSet type, a map based set of values with Add, Remove, Contains and Slice")
+	registry         = flag.Bool("registry", false, "register each type with the enumregistry package for lookup by type name")
+	strictZero       = flag.Bool("strictzero", false, "reserve the zero value to mean unset, failing if any constant is zero")
+	invalidFormat    = flag.String("invalidformat", "", "`format` String gives values without a name, with one %d or %v verb for the number; default is Foo(%d)")
+	parseMode        = flag.String("parsemode", gen.ParseModeMap, "how strings and values are looked up: map, or switch to avoid building maps at init for large enums")
+	exclude          = flag.String("exclude", "", "comma-separated list of methods and functions to leave out, e.g. String,Valid, so hand-written ones can be used")
+	existing         = flag.String("existing", gen.ExistingSkip, "handling of a String method the type already declares: skip generating it, or error")
+	idPrefix         = flag.String("idprefix", "", "prefix for generated identifiers other than methods, e.g. enum gives enumStatusValues")
+	pkgName          = flag.String("pkg", "", "package name for the generated file; default is the name of the source package")
+	templateFile     = flag.String("template", "", "path of a text/template file to use instead of the built-in template")
+	splitFiles       = flag.Bool("splitfiles", false, "write one file per type instead of a combined file")
+	check            = flag.Bool("check", false, "check the output files are up to date instead of writing them, failing if any differ")
+	printVersion     = flag.Bool("version", false, "print the version of enumer and exit")
+	recursive        = flag.Bool("recursive", false, "generate for every package matched by the patterns, e.g. ./..., that declares the types")
 )
 
 func main() {
 	log.SetFlags(0)
 	log.SetPrefix("enumer: ")
+	flag.Usage = usage
 	flag.Parse()
 
+	if *printVersion {
+		fmt.Println("enumer", enumerVersion())
+		return
+	}
 	if len(*typeNames) == 0 {
 		flag.Usage()
 		os.Exit(2)
 	}
 
-	types := strings.Split(*typeNames, ",")
-	for i := range types {
-		types[i] = strings.TrimSpace(types[i])
-	}
+	types := slices.Clone(*typeNames)
 	sort.Strings(types)
+	types = slices.Compact(types)
 
-	// Determine output file name
-	outputName := *output
-	if outputName == "" {
-		if len(types) == 1 {
-			outputName = fmt.Sprintf("%s_enumer.go", strings.ToLower(types[0]))
-		} else if *bitmaskFlag {
-			outputName = "flags_gen.go"
-		} else {
-			outputName = "enums_gen.go"
+	if *splitFiles && *output != "" && !isDirOutput(*output) {
+		log.Fatalf("-output cannot be used with -splitfiles unless it's a directory")
+	}
+	if *check && *output == "-" {
+		log.Fatalf("-check cannot be used with -output=-")
+	}
+	if *recursive && *output != "" {
+		log.Fatalf("-output cannot be used with -recursive")
+	}
+	if *fileTemplate != "" {
+		if _, err := fileTemplateName(*fileTemplate, types[:1]); err != nil {
+			log.Fatalf("Invalid -filetemplate: %v", err)
+		}
+	}
+	opts := newOptions()
+	if err := opts.Validate(); err != nil {
+		log.Fatalf("Invalid options: %v", err)
+	}
+	var customTemplate string
+	if *templateFile != "" {
+		data, err := os.ReadFile(*templateFile)
+		if err != nil {
+			log.Fatalf("Failed to read template: %v", err)
 		}
+		customTemplate = string(data)
 	}
 
-	// Load the package
+	// Load the packages, defaulting to the one in the current directory
+	patterns := flag.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"."}
+	}
 	cfg := &packages.Config{
-		Mode: packages.NeedTypes | packages.NeedTypesInfo | packages.NeedSyntax | packages.NeedName,
+		Mode: gen.LoadMode,
 	}
-	pkgs, err := packages.Load(cfg, ".")
+	if *tags != "" {
+		cfg.BuildFlags = []string{"-tags=" + *tags}
+	}
+	pkgs, err := packages.Load(cfg, patterns...)
 	if err != nil {
 		log.Fatalf("Failed to load package: %v", err)
 	}
-	if len(pkgs) != 1 {
+	if len(pkgs) != 1 && !*recursive {
 		log.Fatalf("Expected exactly one package, got %d", len(pkgs))
 	}
-	pkg := pkgs[0]
 
-	if len(pkg.Errors) > 0 {
-		for _, err := range pkg.Errors {
-			log.Printf("Package error: %v", err)
-		}
+	if reportPackageErrors(pkgs, types) {
 		os.Exit(1)
 	}
-
-	// Process each type
-	allElements := make(map[string][]Element)
-	for _, typeName := range types {
-		elements, err := processType(pkg, typeName)
-		if err != nil {
-			log.Fatalf("Failed to process type %s: %v", typeName, err)
-		}
-		if len(elements) == 0 {
-			log.Fatalf("No constants found for type %s", typeName)
-		}
-		allElements[typeName] = elements
+	for _, pkg := range pkgs {
+		warnIgnoredConstants(pkg, types)
 	}
 
-	// Build command string
-	cmdStr := buildCommandString(types, outputName)
+	// Generate everything before writing, so nothing is written on failure
+	outputs := make(map[string][]byte)
+	var outputNames []string
+	var manifest []gen.ManifestEnum
+	for _, pkg := range pkgs {
+		// Packages that don't declare any of the types are skipped when recursing
+		pkgTypes := types
+		if *recursive {
+			pkgTypes = declaredTypes(pkg, types)
+		}
 
-	// Generate code
-	data := TemplateData{
-		PackageName: pkg.Name,
-		Types:       types,
-		Elements:    allElements,
-		TrimPrefix:  *trimPrefix,
-		SQL:         *sqlFlag,
-		JSON:        *jsonFlag,
-		YAML:        *yamlFlag,
-		Bitmask:     *bitmaskFlag,
-		Command:     cmdStr,
-	}
+		for _, group := range groupTypes(pkgTypes) {
+			groupOpts, err := groupOptions(pkg, group, opts)
+			if err != nil {
+				log.Fatalf("Failed to generate code for %s: %v", pkg.PkgPath, err)
+			}
 
-	if err := generateCode(outputName, data); err != nil {
-		log.Fatalf("Failed to generate code: %v", err)
-	}
-}
+			// Directives can choose the name, e.g. by making the types bitmasks
+			outputName := *output
+			switch {
+			case outputName == "":
+				outputName = filepath.Join(packageDir(pkg), defaultOutputName(group, groupOpts))
+			case isDirOutput(outputName):
+				outputName = filepath.Join(outputName, defaultOutputName(group, groupOpts))
+			}
+			genCfg := gen.Config{
+				Package:     pkg,
+				Types:       group,
+				Command:     buildCommandString(group),
+				Version:     version,
+				Platform:    pinnedPlatform(),
+				PackageName: *pkgName,
+				Template:    customTemplate,
+				Options:     groupOpts,
+			}
+			src, err := gen.Generate(genCfg)
+			if err != nil {
+				log.Fatalf("Failed to generate code for %s: %v", pkg.PkgPath, err)
+			}
+			outputs[outputName] = src
+			outputNames = append(outputNames, outputName)
 
-// buildCommandString constructs the command line used to generate the code
-func buildCommandString(types []string, outputName string) string {
-	var parts []string
-	parts = append(parts, "enumer")
-	parts = append(parts, fmt.Sprintf("-type=%s", strings.Join(types, ",")))
+			// The YAML methods go in a file of their own, behind a build tag
+			if groupOpts.YAMLBuildTag {
+				if outputName == "-" {
+					log.Fatalf("-yaml=buildtag cannot be used with -output=-, as it writes a second file")
+				}
+				src, err := gen.GenerateYAML(genCfg)
+				if err != nil {
+					log.Fatalf("Failed to generate YAML methods for %s: %v", pkg.PkgPath, err)
+				}
+				yamlName := strings.TrimSuffix(outputName, ".go") + "_yaml.go"
+				outputs[yamlName] = src
+				outputNames = append(outputNames, yamlName)
+			}
 
-	if *trimPrefix != "" {
-		parts = append(parts, fmt.Sprintf("-trimprefix=%s", *trimPrefix))
+			if *manifestPath != "" {
+				enums, err := gen.Manifest(genCfg)
+				if err != nil {
+					log.Fatalf("Failed to describe types for %s: %v", pkg.PkgPath, err)
+				}
+				manifest = append(manifest, enums...)
+			}
+		}
 	}
-	if *lineComment {
-		parts = append(parts, "-linecomment")
+	if len(outputNames) == 0 {
+		log.Fatalf("No package declares any of the types %s", strings.Join(types, ","))
 	}
-	if *jsonFlag {
-		parts = append(parts, "-json")
+	if *manifestPath != "" {
+		data, err := json.MarshalIndent(struct {
+			Enums []gen.ManifestEnum `json:"enums"`
+		}{manifest}, "", "  ")
+		if err != nil {
+			log.Fatalf("Failed to encode manifest: %v", err)
+		}
+		outputs[*manifestPath] = append(data, '\n')
+		outputNames = append(outputNames, *manifestPath)
 	}
-	if *yamlFlag {
-		parts = append(parts, "-yaml")
+
+	if *check {
+		stale := false
+		for _, outputName := range outputNames {
+			if err := checkOutput(outputName, outputs[outputName]); err != nil {
+				log.Printf("%v", err)
+				stale = true
+			}
+		}
+		if stale {
+			os.Exit(1)
+		}
+		return
 	}
-	if *sqlFlag {
-		parts = append(parts, "-sql")
+
+	for _, outputName := range outputNames {
+		if err := writeOutput(outputName, outputs[outputName]); err != nil {
+			log.Fatalf("Failed to write output: %v", err)
+		}
 	}
-	if *output != "" {
-		parts = append(parts, fmt.Sprintf("-output=%s", *output))
+}
+
+// reportPackageErrors logs the errors of any packages that failed to load,
+// grouped by package, and reports whether there were any. Nothing is
+// generated for a package that doesn't type-check, as its constants can't
+// be evaluated reliably
+func reportPackageErrors(pkgs []*packages.Package, types []string) bool {
+	failed := false
+	for _, pkg := range pkgs {
+		if len(pkg.Errors) == 0 {
+			continue
+		}
+		failed = true
+		log.Printf("Failed to load package %s:", pkg.ID)
+
+		// The same error can be reported more than once
+		seen := make(map[string]bool)
+		for _, err := range pkg.Errors {
+			if msg := formatPackageError(err); !seen[msg] {
+				log.Printf("\t%s", msg)
+				seen[msg] = true
+			}
+		}
 	}
-	if *bitmaskFlag {
-		parts = append(parts, "-bitmask")
+	if failed {
+		log.Printf("No code was generated for %s; fix the errors above and run enumer again", strings.Join(types, ","))
 	}
-
-	return strings.Join(parts, " ")
+	return failed
 }
 
-// Element represents a single enum constant
-type Element struct {
-	Name        string
-	Value       string
-	StringValue string
+// formatPackageError formats an error with its position, relative to the
+// current directory where possible, or its kind when it has no position
+func formatPackageError(err packages.Error) string {
+	if err.Pos == "" || err.Pos == "-" {
+		switch err.Kind {
+		case packages.ListError:
+			return "go list: " + err.Msg
+		case packages.ParseError:
+			return "parse error: " + err.Msg
+		case packages.TypeError:
+			return "type error: " + err.Msg
+		}
+		return err.Msg
+	}
+	return relativePos(err.Pos) + ": " + err.Msg
 }
 
-// TemplateData holds all data needed for template execution
-type TemplateData struct {
-	PackageName string
-	Types       []string
-	Elements    map[string][]Element
-	TrimPrefix  string
-	SQL         bool
-	JSON        bool
-	YAML        bool
-	Bitmask     bool
-	Command     string
+// relativePos shortens a position in the current directory to be relative
+// to it
+func relativePos(pos string) string {
+	if wd, err := os.Getwd(); err == nil {
+		if rel, ok := strings.CutPrefix(pos, wd+string(filepath.Separator)); ok {
+			return rel
+		}
+	}
+	return pos
 }
 
-// processType extracts all constants for a given type
-func processType(pkg *packages.Package, typeName string) ([]Element, error) {
-	// Find the type
-	obj := pkg.Types.Scope().Lookup(typeName)
-	if obj == nil {
-		return nil, fmt.Errorf("type %s not found", typeName)
+// warnIgnoredConstants warns about files excluded by build constraints that
+// declare constants of the types, as they're missing from the output
+func warnIgnoredConstants(pkg *packages.Package, types []string) {
+	wanted := make(map[string]bool)
+	for _, typeName := range types {
+		wanted[typeName] = true
 	}
 
-	targetType := obj.Type()
-	var elements []Element
-
-	// Iterate through all files in the package
-	for _, file := range pkg.Syntax {
+	fset := token.NewFileSet()
+	for _, filename := range pkg.IgnoredFiles {
+		file, err := parser.ParseFile(fset, filename, nil, parser.SkipObjectResolution)
+		if err != nil {
+			continue
+		}
+		found := make(map[string]bool)
 		for _, decl := range file.Decls {
 			gd, ok := decl.(*ast.GenDecl)
 			if !ok || gd.Tok != token.CONST {
 				continue
 			}
-
 			for _, spec := range gd.Specs {
-				vspec := spec.(*ast.ValueSpec)
-
-				for _, name := range vspec.Names {
-					// Check if this constant matches our target type
-					constObj := pkg.TypesInfo.Defs[name]
-					if constObj == nil || !types.Identical(constObj.Type(), targetType) {
-						continue
-					}
-
-					// Get the constant value
-					constValue := constObj.(*types.Const).Val()
-
-					// Get string value (trim prefix if required)
-					stringValue := name.Name
-					if *trimPrefix != "" {
-						stringValue = strings.TrimPrefix(stringValue, *trimPrefix)
-					}
-
-					// Override string value with comment if present
-					if *lineComment && vspec.Comment != nil {
-						comment := strings.TrimSpace(vspec.Comment.Text())
-						if comment != "" {
-							stringValue = comment
-						}
-					}
-
-					elements = append(elements, Element{
-						Name:        name.Name,
-						Value:       constValue.ExactString(),
-						StringValue: stringValue,
-					})
-
+				if ident, ok := spec.(*ast.ValueSpec).Type.(*ast.Ident); ok && wanted[ident.Name] && !found[ident.Name] {
+					found[ident.Name] = true
+					log.Printf("Warning: %s declares %s constants but is excluded by build constraints; use -tags to include them", filename, ident.Name)
 				}
 			}
 		}
 	}
-
-	return elements, nil
 }
 
-// generateCode creates the output file from the template
-func generateCode(filename string, data TemplateData) error {
-	tmpl, err := template.New("enumer").Funcs(template.FuncMap{
-		"lower": strings.ToLower,
-	}).Parse(codeTemplate)
-	if err != nil {
-		return fmt.Errorf("failed to parse template: %w", err)
-	}
-
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, data); err != nil {
-		return fmt.Errorf("failed to execute template: %w", err)
+// groupTypes splits the types into those generated together, one file per
+// type when splitting and otherwise a combined file
+func groupTypes(types []string) [][]string {
+	if len(types) == 0 {
+		return nil
 	}
-
-	// Write to file
-	if err := os.WriteFile(filename, buf.Bytes(), 0644); err != nil {
-		return fmt.Errorf("failed to write file: %w", err)
+	if !*splitFiles {
+		return [][]string{types}
 	}
-
-	// Format with gofmt
-	cmd := exec.Command("gofmt", "-w", filename)
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("failed to format file: %w", err)
+	var groups [][]string
+	for _, typeName := range types {
+		groups = append(groups, []string{typeName})
 	}
-
-	return nil
+	return groups
 }
 
-const codeTemplate = `// Code generated by enumer; DO NOT EDIT.
-// See: https://github.com/spaceweasel/enumer
-// Command: {{.Command}}
-
-package {{.PackageName}}
-
-import (
-{{- if .SQL}}
-	"database/sql/driver"
-{{- end}}
-	"fmt"
-{{- if .JSON}}
-	"encoding/json"
-{{- end}}
-{{- if .YAML}}
-	"gopkg.in/yaml.v3"
-{{- end}}
-)
-
-{{range $typeName := .Types}}
-{{$elements := index $.Elements $typeName}}
-{{$trimPrefix := $.TrimPrefix}}
-
-var _{{$typeName}}Map = map[{{$typeName}}]string{
-{{- range $elements}}
-	{{.Name}}: "{{.StringValue}}",
-{{- end}}
-}
-
-var _{{$typeName}}Values = []{{$typeName}}{
-{{- range $elements}}
-	{{.Name}},
-{{- end}}
-}
-
-var _{{$typeName}}NameToValueMap = map[string]{{$typeName}}{
-{{- range $elements}}
-	"{{.StringValue}}": {{.Name}},
-{{- end}}
+// declaredTypes returns the types declared in the package's scope
+func declaredTypes(pkg *packages.Package, types []string) []string {
+	var declared []string
+	for _, typeName := range types {
+		if pkg.Types.Scope().Lookup(typeName) != nil {
+			declared = append(declared, typeName)
+		}
+	}
+	return declared
 }
 
-// String returns the string representation of the {{$typeName}} value
-func (i {{$typeName}}) String() string {
-	if str, ok := _{{$typeName}}Map[i]; ok {
-		return str
+// defaultOutputName returns the output file name used when -output isn't set
+func defaultOutputName(types []string, opts gen.Options) string {
+	if *fileTemplate != "" {
+		// The pattern was checked before generating
+		name, _ := fileTemplateName(*fileTemplate, types)
+		return name
+	}
+	if len(types) == 1 {
+		return fmt.Sprintf("%s_enumer.go", strings.ToLower(types[0]))
+	}
+	if opts.Bitmask {
+		return "flags_gen.go"
 	}
-	return fmt.Sprintf("{{$typeName}}(%d)", i)
+	return "enums_gen.go"
 }
 
-// {{$typeName}}Values returns all values of the enum
-func {{$typeName}}Values() []{{$typeName}} {
-	return _{{$typeName}}Values
+// fileTemplateName returns the file name given by a -filetemplate pattern for
+// the types, which are joined with underscores for a combined file
+func fileTemplateName(pattern string, types []string) (string, error) {
+	tmpl, err := template.New("filetemplate").Option("missingkey=error").Parse(pattern)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, struct{ Type string }{strings.ToLower(strings.Join(types, "_"))}); err != nil {
+		return "", err
+	}
+	name := b.String()
+	switch {
+	case filepath.Ext(name) != ".go" || name == ".go":
+		return "", fmt.Errorf("%q doesn't give a .go file name", pattern)
+	case strings.ContainsRune(name, '/') || strings.ContainsRune(name, filepath.Separator):
+		return "", fmt.Errorf("%q gives a path rather than a file name; use -output for the directory", pattern)
+	case strings.HasSuffix(name, "_test.go"):
+		return "", fmt.Errorf("%q gives a test file name", pattern)
+	}
+	return name, nil
 }
 
-// {{$typeName}}String retrieves an enum value from the string representation
-func {{$typeName}}String(s string) ({{$typeName}}, error) {
-	if val, ok := _{{$typeName}}NameToValueMap[s]; ok {
-		return val, nil
+// isDirOutput reports whether -output names a directory to write the
+// default file names into, as it exists or ends in a separator
+func isDirOutput(output string) bool {
+	if output == "-" {
+		return false
+	}
+	if strings.HasSuffix(output, "/") || strings.HasSuffix(output, string(filepath.Separator)) {
+		return true
 	}
-	return 0, fmt.Errorf("%s is not a valid {{$typeName}}", s)
+	info, err := os.Stat(output)
+	return err == nil && info.IsDir()
 }
 
-// Valid returns true if the value is a valid {{$typeName}}
-func (i {{$typeName}}) Valid() bool {
-	_, ok := _{{$typeName}}Map[i]
-	return ok
+// packageDir returns the directory containing the package's source files,
+// falling back to the current directory if it has none
+func packageDir(pkg *packages.Package) string {
+	if len(pkg.GoFiles) == 0 {
+		return "."
+	}
+	return filepath.Dir(pkg.GoFiles[0])
 }
 
-{{if $.Bitmask}}
-// Has returns true if the flag is set in the {{$typeName}} value
-func (i {{$typeName}}) Has(flag {{$typeName}}) bool {
-	return i&flag != 0
+// pinnedPlatform returns the GOOS/GOARCH the packages are loaded for when
+// both are set explicitly, and otherwise ""
+func pinnedPlatform() string {
+	goos, goarch := os.Getenv("GOOS"), os.Getenv("GOARCH")
+	if goos == "" || goarch == "" {
+		return ""
+	}
+	return goos + "/" + goarch
 }
 
-// HasAny returns true if any of the provided flags are set in the {{$typeName}} value
-func (i {{$typeName}}) HasAny(flags ...{{$typeName}}) bool {
-	for _, flag := range flags {
-		if i&flag != 0 {
-			return true
-		}
+// newOptions builds the generator options from the command line flags
+func newOptions() gen.Options {
+	return gen.Options{
+		TrimPrefix:       *trimPrefix,
+		TrimSuffix:       *trimSuffix,
+		AddPrefix:        *addPrefix,
+		Transform:        *transform,
+		BuildTags:        *buildTags,
+		GoFile:           *goFile,
+		Comment:          *comment,
+		IDPrefix:         *idPrefix,
+		LineComment:      lineComment.value,
+		SQL:              sqlFlag.value,
+		Nullable:         *nullable,
+		JSON:             jsonFlag.value,
+		YAML:             yamlFlag.value != "",
+		YAMLBuildTag:     yamlFlag.value == "buildtag",
+		YAMLVersion:      *yamlVersion,
+		Text:             *textFlag,
+		FlagValue:        *flagValue,
+		Binary:           *binaryFlag,
+		GQL:              *gqlFlag,
+		XML:              *xmlFlag,
+		Bitmask:          bitmaskFlag.value != "",
+		BitmaskBits:      bitmaskFlag.value == "bits",
+		CaseInsensitive:  *caseInsensitive,
+		ParseNumber:      *parseNumber,
+		ParsePlaceholder: *parsePlaceholder,
+		InvalidFormat:    *invalidFormat,
+		TrimSpace:        *trimSpace,
+		Descriptions:     *descriptions,
+		Labels:           *labels,
+		GoString:         *goString,
+		DocValues:        *docValues,
+		Deprecation:      *deprecation,
+		Iter:             *iterFlag,
+		Navigation:       *navigation,
+		WithDefault:      *withDefault,
+		Normalize:        *normalize,
+		CSV:              *csvFlag,
+		Batch:            *batch,
+		SetType:          *setType,
+		ValidateFunc:     *validateFunc,
+		Registry:         *registry,
+		StrictZero:       *strictZero,
+		Existing:         *existing,
+		ParseMode:        *parseMode,
+		Exclude:          *exclude,
 	}
-	return false
 }
 
-// HasAll returns true if all of the provided flags are set in the {{$typeName}} value
-func (i {{$typeName}}) HasAll(flags ...{{$typeName}}) bool {
-	for _, flag := range flags {
-		if i&flag == 0 {
-			return false
+// writeOutput writes generated source to the named file, or to stdout
+// when the name is "-"
+func writeOutput(filename string, src []byte) error {
+	if filename == "-" {
+		if _, err := os.Stdout.Write(src); err != nil {
+			return fmt.Errorf("failed to write to stdout: %w", err)
 		}
+		return nil
 	}
-	return true
-}
-
-// Set returns a new {{$typeName}} with the specified flags set
-func (i {{$typeName}}) Set(flags ...{{$typeName}}) {{$typeName}} {
-	result := i
-	for _, flag := range flags {
-		result |= flag
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
 	}
-	return result
-}
-
-// Clear returns a new {{$typeName}} with the specified flags cleared
-func (i {{$typeName}}) Clear(flags ...{{$typeName}}) {{$typeName}} {
-	result := i
-	for _, flag := range flags {
-		result &^= flag
+	if err := os.WriteFile(filename, src, 0644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
 	}
-	return result
+	return nil
 }
 
-// Toggle returns a new {{$typeName}} with the specified flags toggled
-func (i {{$typeName}}) Toggle(flags ...{{$typeName}}) {{$typeName}} {
-	result := i
-	for _, flag := range flags {
-		result ^= flag
+// checkOutput compares generated source with the named file, describing
+// the first difference if they don't match
+func checkOutput(filename string, src []byte) error {
+	existing, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("%s is out of date: %w", filename, err)
 	}
-	return result
-}
-{{end}}
-
-{{if $.JSON}}
-// MarshalJSON implements the json.Marshaler interface for {{$typeName}}
-func (i {{$typeName}}) MarshalJSON() ([]byte, error) {
-	return json.Marshal(i.String())
-}
-
-// UnmarshalJSON implements the json.Unmarshaler interface for {{$typeName}}
-func (i *{{$typeName}}) UnmarshalJSON(data []byte) error {
-	var s string
-	if err := json.Unmarshal(data, &s); err != nil {
-		return fmt.Errorf("{{$typeName}} should be a string, got %s", data)
+	if bytes.Equal(existing, src) {
+		return nil
 	}
 
-	var err error
-	*i, err = {{$typeName}}String(s)
-	return err
-}
-{{end}}
-
-{{if $.YAML}}
-// MarshalYAML implements the yaml.Marshaler interface for {{$typeName}}
-func (i {{$typeName}}) MarshalYAML() (any, error) {
-	return i.String(), nil
-}
-
-// UnmarshalYAML implements the yaml.Unmarshaler interface for {{$typeName}}
-func (i *{{$typeName}}) UnmarshalYAML(node *yaml.Node) error {
-	var s string
-	if err := node.Decode(&s); err != nil {
-		return fmt.Errorf("{{$typeName}} should be a string, got %v", node.Value)
+	have := strings.Split(string(existing), "\n")
+	want := strings.Split(string(src), "\n")
+	line := 0
+	for line < len(have) && line < len(want) && have[line] == want[line] {
+		line++
 	}
-
-	var err error
-	*i, err = {{$typeName}}String(s)
-	return err
-}
-{{end}}
-
-{{if $.SQL}}
-// Scan implements the sql.Scanner interface for {{$typeName}}
-func (i *{{$typeName}}) Scan(value any) error {
-	if value == nil {
-		return nil
+	switch {
+	case line == len(have):
+		return fmt.Errorf("%s is out of date: missing lines from line %d: %q", filename, line+1, want[line])
+	case line == len(want):
+		return fmt.Errorf("%s is out of date: unexpected lines from line %d: %q", filename, line+1, have[line])
 	}
+	return fmt.Errorf("%s is out of date: line %d is %q, want %q", filename, line+1, have[line], want[line])
+}
 
-	var s string
-	switch v := value.(type) {
-	case string:
-		s = v
-	case []byte:
-		s = string(v)
-	default:
-		return fmt.Errorf("cannot scan type %T into {{$typeName}}", value)
+// buildCommandString constructs the command line used to generate the
+// code. Every flag that was set is included, in name order so the command is
+// stable, followed by the package patterns
+func buildCommandString(types []string) string {
+	parts := []string{"enumer", "-type=" + quoteArg(strings.Join(types, ","))}
+
+	flag.Visit(func(f *flag.Flag) {
+		// Flags set to their default don't change the output, and checking
+		// must produce the same command as generating
+		if f.Name == "type" || f.Name == "check" || f.Value.String() == f.DefValue {
+			return
+		}
+		if m, ok := f.Value.(*modeFlag); ok {
+			parts = append(parts, m.arg(f.Name))
+			return
+		}
+		if b, ok := f.Value.(interface{ IsBoolFlag() bool }); ok && b.IsBoolFlag() && f.Value.String() == "true" {
+			parts = append(parts, "-"+f.Name)
+			return
+		}
+		parts = append(parts, fmt.Sprintf("-%s=%s", f.Name, quoteArg(f.Value.String())))
+	})
+	for _, arg := range flag.Args() {
+		parts = append(parts, quoteArg(arg))
 	}
 
-	var err error
-	*i, err = {{$typeName}}String(s)
-	return err
+	return strings.Join(parts, " ")
 }
 
-// Value implements the driver.Valuer interface for {{$typeName}}
-func (i {{$typeName}}) Value() (driver.Value, error) {
-	return i.String(), nil
+// quoteArg quotes a command line argument if it's empty or contains
+// characters that a shell or go:generate would treat specially
+func quoteArg(s string) string {
+	if s == "" || strings.ContainsAny(s, " \t\n\"'\\$`!&|;<>()*?[]{}#~") {
+		return strconv.Quote(s)
+	}
+	return s
 }
-{{end}}
-
-{{end}}
-`
@@ -0,0 +1,10 @@
+package testpkg
+
+// Permission represents an enum whose strings are namespaced
+type Permission int
+
+const (
+	PermRead Permission = iota
+	PermWrite
+	PermExecute
+)
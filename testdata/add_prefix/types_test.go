@@ -0,0 +1,58 @@
+package testpkg
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPermissionAddPrefix(t *testing.T) {
+	tests := []struct {
+		value    Permission
+		expected string
+	}{
+		{PermRead, "perm.Read"},
+		{PermWrite, "perm.Write"},
+		{PermExecute, "perm.Execute"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.value.String(); got != tt.expected {
+			t.Errorf("Expected %q, got %q", tt.expected, got)
+		}
+
+		parsed, err := PermissionString(tt.expected)
+		if err != nil {
+			t.Errorf("Failed to parse %q: %v", tt.expected, err)
+			continue
+		}
+		if parsed != tt.value {
+			t.Errorf("Parsing %q: expected %v, got %v", tt.expected, tt.value, parsed)
+		}
+	}
+}
+
+func TestPermissionAddPrefixUnprefixed(t *testing.T) {
+	for _, name := range []string{"Read", "PermRead", "perm.PermRead"} {
+		if _, err := PermissionString(name); err == nil {
+			t.Errorf("Should not be able to parse %q", name)
+		}
+	}
+}
+
+func TestPermissionAddPrefixJSON(t *testing.T) {
+	data, err := json.Marshal(PermWrite)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != `"perm.Write"` {
+		t.Errorf("Expected \"perm.Write\", got %s", data)
+	}
+
+	var p Permission
+	if err := json.Unmarshal(data, &p); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if p != PermWrite {
+		t.Errorf("Expected PermWrite, got %v", p)
+	}
+}
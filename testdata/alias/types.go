@@ -0,0 +1,14 @@
+package testpkg
+
+// Status represents an enum with an alias constant
+type Status int
+
+const (
+	Pending Status = iota
+	Running
+	Success
+	Failure
+
+	// Active is an alias of Running kept for backward compatibility
+	Active = Running
+)
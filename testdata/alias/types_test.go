@@ -0,0 +1,93 @@
+package testpkg
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStatusAliasParse(t *testing.T) {
+	for _, name := range []string{"Running", "Active"} {
+		s, err := StatusString(name)
+		if err != nil {
+			t.Fatalf("Failed to parse %q: %v", name, err)
+		}
+		if s != Running {
+			t.Errorf("Parsing %q: expected Running, got %v", name, s)
+		}
+	}
+}
+
+func TestStatusAliasString(t *testing.T) {
+	// The first declared name is canonical
+	if Active.String() != "Running" {
+		t.Errorf("Expected 'Running', got %q", Active.String())
+	}
+}
+
+func TestStatusAliasValues(t *testing.T) {
+	values := StatusValues()
+	if len(values) != 4 {
+		t.Fatalf("Expected 4 distinct values, got %d: %v", len(values), values)
+	}
+	if values[1] != Running {
+		t.Errorf("Expected Running at index 1, got %v", values[1])
+	}
+}
+
+func TestStatusEntriesIgnoreAliases(t *testing.T) {
+	expected := []StatusEntry{{"Pending", Pending}, {"Running", Running}, {"Success", Success}, {"Failure", Failure}}
+	entries := StatusEntries()
+	if len(entries) != len(expected) {
+		t.Fatalf("Expected %d entries, got %d: %v", len(expected), len(entries), entries)
+	}
+	for i := range expected {
+		if entries[i] != expected[i] {
+			t.Errorf("Entry %d: expected %v, got %v", i, expected[i], entries[i])
+		}
+	}
+}
+
+func TestStatusAliasJSON(t *testing.T) {
+	var s Status
+	if err := json.Unmarshal([]byte(`"Active"`), &s); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != `"Running"` {
+		t.Errorf("Expected \"Running\", got %s", data)
+	}
+}
+
+func TestStatusLenIgnoresAliases(t *testing.T) {
+	if StatusLen() != len(StatusValues()) {
+		t.Errorf("StatusLen() should be %d, got %d", len(StatusValues()), StatusLen())
+	}
+}
+
+func TestStatusValuesMap(t *testing.T) {
+	m := StatusValuesMap()
+	if len(m) != 5 || m["Active"] != Running || m["Running"] != Running {
+		t.Errorf("StatusValuesMap() should include every name, including aliases, got %v", m)
+	}
+
+	// Changes to the returned map don't affect parsing
+	m["Running"] = Pending
+	delete(m, "Active")
+	m["Bogus"] = Running
+
+	if s, err := StatusString("Running"); err != nil || s != Running {
+		t.Errorf("StatusString(Running) should be unaffected, got %v, %v", s, err)
+	}
+	if s, err := StatusString("Active"); err != nil || s != Running {
+		t.Errorf("StatusString(Active) should be unaffected, got %v, %v", s, err)
+	}
+	if _, err := StatusString("Bogus"); err == nil {
+		t.Error("StatusString(Bogus) should fail")
+	}
+	if StatusValuesMap()["Running"] != Running {
+		t.Error("each call should return a fresh copy")
+	}
+}
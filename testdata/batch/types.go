@@ -0,0 +1,10 @@
+package testpkg
+
+// Status represents an enum parsed in batches
+type Status int
+
+const (
+	Pending Status = iota + 1
+	Running
+	Done
+)
@@ -0,0 +1,49 @@
+package testpkg
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestStatusStrings(t *testing.T) {
+	values, err := StatusStrings([]string{"Pending", "Running", "Done"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []Status{Pending, Running, Done}
+	for i := range expected {
+		if values[i] != expected[i] {
+			t.Errorf("Index %d: expected %v, got %v", i, expected[i], values[i])
+		}
+	}
+}
+
+func TestStatusStringsReportsEveryFailure(t *testing.T) {
+	values, err := StatusStrings([]string{"Pending", "Paused", "Done", "Stopped"})
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	if !errors.Is(err, ErrInvalidStatus) {
+		t.Errorf("Expected error to wrap ErrInvalidStatus, got %v", err)
+	}
+	for _, want := range []string{"index 1: Paused", "index 3: Stopped"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected error to contain %q, got %q", want, err)
+		}
+	}
+	if n := len(err.(interface{ Unwrap() []error }).Unwrap()); n != 2 {
+		t.Errorf("Expected 2 joined errors, got %d", n)
+	}
+
+	// The valid strings are still parsed
+	expected := []Status{Pending, 0, Done, 0}
+	if len(values) != len(expected) {
+		t.Fatalf("Expected %d values, got %d", len(expected), len(values))
+	}
+	for i := range expected {
+		if values[i] != expected[i] {
+			t.Errorf("Index %d: expected %v, got %v", i, expected[i], values[i])
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package testpkg
+
+// Tier is stored in gob encoded cache entries
+type Tier int
+
+const (
+	Bronze Tier = iota + 1
+	Silver
+	Gold
+)
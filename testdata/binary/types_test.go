@@ -0,0 +1,60 @@
+package testpkg
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"errors"
+	"testing"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = Bronze
+	_ encoding.BinaryUnmarshaler = (*Tier)(nil)
+)
+
+type entry struct {
+	Key  string
+	Tier Tier
+}
+
+func TestTierMarshalBinary(t *testing.T) {
+	data, err := Silver.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary failed: %v", err)
+	}
+	if string(data) != "Silver" {
+		t.Errorf("MarshalBinary should produce Silver, got %q", data)
+	}
+}
+
+func TestTierUnmarshalBinaryInvalid(t *testing.T) {
+	var tier Tier
+	if err := tier.UnmarshalBinary([]byte("Platinum")); !errors.Is(err, ErrInvalidTier) {
+		t.Errorf("UnmarshalBinary(Platinum) should fail with ErrInvalidTier, got %v", err)
+	}
+}
+
+func TestTierGobRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	in := []entry{{"a", Bronze}, {"b", Gold}}
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+	if !bytes.Contains(buf.Bytes(), []byte("Gold")) {
+		t.Error("gob data should contain the string representation")
+	}
+
+	var out []entry
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	if len(out) != len(in) {
+		t.Fatalf("Decode should produce %v, got %v", in, out)
+	}
+	for i := range in {
+		if out[i] != in[i] {
+			t.Errorf("entry %d should be %v, got %v", i, in[i], out[i])
+		}
+	}
+}
@@ -0,0 +1,12 @@
+package testpkg
+
+// Permission represents a flag-based enum whose combinations are all
+// meaningful, so any of them is valid
+type Permission int
+
+const (
+	Read Permission = 1 << iota
+	Write
+	Execute
+	Admin = Read | Write | Execute
+)
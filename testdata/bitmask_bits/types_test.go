@@ -0,0 +1,47 @@
+package testpkg
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPermissionBitsValidation(t *testing.T) {
+	// With -bitmask=bits, combinations of known flags are valid
+	for _, p := range []Permission{Read, Admin, Read | Write, Write | Execute, 0} {
+		if !p.Valid() {
+			t.Errorf("%v should be valid", p)
+		}
+	}
+
+	// Bits that don't belong to a flag are not
+	for _, p := range []Permission{Read | 16, Permission(-1)} {
+		if p.Valid() {
+			t.Errorf("%d should not be valid", int(p))
+		}
+	}
+	if _PermissionAllBits != Admin {
+		t.Errorf("_PermissionAllBits should be 7, got %d", _PermissionAllBits)
+	}
+}
+
+func TestPermissionBitsFromValue(t *testing.T) {
+	if p, err := PermissionFromValue(int(Read | Execute)); err != nil || p != Read|Execute {
+		t.Errorf("Expected Read|Execute, got %v, %v", p, err)
+	}
+	if _, err := PermissionFromValue(16); err == nil {
+		t.Error("Expected an unknown bit to fail")
+	}
+
+	var p Permission
+	if err := json.Unmarshal([]byte("5"), &p); err != nil || p != Read|Execute {
+		t.Errorf("Expected 5 to unmarshal as Read|Execute, got %v, %v", p, err)
+	}
+}
+
+func TestPermissionBitsRoundTrip(t *testing.T) {
+	for _, p := range []Permission{Read, Read | Write, Admin, 0} {
+		if got, err := PermissionString(p.String()); err != nil || got != p {
+			t.Errorf("Round trip of %d failed: got %d, %v", p, got, err)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+package testpkg
+
+// RunStatus represents a flag-based enum whose composites are meaningful,
+// so only the named values are valid
+type RunStatus int
+
+const (
+	Pending RunStatus = 1 << iota
+	Running
+	Success
+	Failure
+	Skipped
+	Completed RunStatus = Success | Failure | Skipped
+)
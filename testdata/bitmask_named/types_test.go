@@ -0,0 +1,66 @@
+package testpkg
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestRunStatusNamedValidation(t *testing.T) {
+	for _, s := range []RunStatus{Pending, Running, Completed} {
+		if !s.Valid() {
+			t.Errorf("%v should be valid", s)
+		}
+	}
+
+	// By default, combinations without a name aren't valid
+	if (Pending | Running).Valid() {
+		t.Error("Unnamed combination of flags should not be valid")
+	}
+	if (Pending | Completed).Valid() {
+		t.Error("Flag combined with a named composite should not be valid")
+	}
+	if (Pending | 64).Valid() {
+		t.Error("Combination with an unknown bit should not be valid")
+	}
+}
+
+func TestRunStatusNamedParse(t *testing.T) {
+	// The flags of a named composite parse to it
+	s, err := RunStatusString("Success|Failure|Skipped")
+	if err != nil || s != Completed {
+		t.Errorf("Expected Completed, got %v, %v", s, err)
+	}
+
+	// Unnamed combinations parse, so String output round trips, but
+	// aren't valid
+	s, err = RunStatusString("Pending|Running")
+	if err != nil || s != Pending|Running {
+		t.Errorf("Expected Pending|Running, got %v, %v", s, err)
+	}
+	if s.Valid() {
+		t.Error("Parsed unnamed combination should not be valid")
+	}
+	if s, err := RunStatusString(RunStatus(0).String()); err != nil || s != 0 {
+		t.Errorf("Expected the empty set to round trip, got %v, %v", s, err)
+	}
+	if _, err := RunStatusFromValue(int(Pending | Running)); !errors.Is(err, ErrInvalidRunStatus) {
+		t.Errorf("Expected ErrInvalidRunStatus for an unnamed value, got %v", err)
+	}
+
+	var v RunStatus
+	if err := json.Unmarshal([]byte("3"), &v); err == nil {
+		t.Error("Expected an unnamed number not to unmarshal")
+	}
+}
+
+func TestRunStatusNamedHelpers(t *testing.T) {
+	// The bitwise methods are the same in either mode
+	s := Pending.Set(Running)
+	if !s.HasAll(Pending, Running) {
+		t.Errorf("Expected Pending and Running to be set, got %v", s)
+	}
+	if s.String() != "Pending|Running" {
+		t.Errorf("Expected Pending|Running, got %q", s.String())
+	}
+}
@@ -0,0 +1,10 @@
+package testpkg
+
+// Caps is a uint64 bitmask whose last flag uses the high bit
+type Caps uint64
+
+const (
+	CapRead Caps = 1 << iota
+	CapWrite
+	CapAdmin Caps = 1 << 63
+)
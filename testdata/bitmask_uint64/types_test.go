@@ -0,0 +1,95 @@
+package testpkg
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCapsString(t *testing.T) {
+	tests := []struct {
+		caps Caps
+		want string
+	}{
+		{CapAdmin, "CapAdmin"},
+		{CapRead | CapAdmin, "CapRead|CapAdmin"},
+		{CapAdmin | 1<<62, "CapAdmin|Caps(4611686018427387904)"},
+		{1 << 62, "Caps(4611686018427387904)"},
+		{0, "Caps(0)"},
+	}
+	for _, tt := range tests {
+		if got := tt.caps.String(); got != tt.want {
+			t.Errorf("Caps(%d).String() should be %q, got %q", uint64(tt.caps), tt.want, got)
+		}
+	}
+}
+
+func TestCapsHighBit(t *testing.T) {
+	caps := CapRead.Set(CapAdmin)
+	if !caps.Has(CapAdmin) || !caps.Has(CapRead) || caps.Has(CapWrite) {
+		t.Errorf("%v should have only CapRead and CapAdmin set", caps)
+	}
+	if caps.Has(1 << 62) {
+		t.Errorf("%v should not have bit 62 set", caps)
+	}
+	if !caps.Valid() {
+		t.Errorf("%v should be valid", caps)
+	}
+	if Caps(1 << 62).Valid() {
+		t.Error("Caps(1 << 62) should not be valid")
+	}
+
+	if all := CapsAll(); all != CapRead|CapWrite|CapAdmin {
+		t.Errorf("CapsAll() should be %d, got %d", uint64(CapRead|CapWrite|CapAdmin), uint64(all))
+	}
+	if max := CapsMax(); max != CapAdmin {
+		t.Errorf("CapsMax() should be CapAdmin, got %v", max)
+	}
+	flags := caps.Flags()
+	if len(flags) != 2 || flags[0] != CapRead || flags[1] != CapAdmin {
+		t.Errorf("%v.Flags() should be [CapRead CapAdmin], got %v", caps, flags)
+	}
+}
+
+func TestCapsParse(t *testing.T) {
+	tests := []struct {
+		s    string
+		want Caps
+	}{
+		{"CapAdmin", CapAdmin},
+		{"CapWrite|CapAdmin", CapWrite | CapAdmin},
+		{"9223372036854775808", CapAdmin},
+	}
+	for _, tt := range tests {
+		got, err := CapsString(tt.s)
+		if err != nil {
+			t.Errorf("CapsString(%q) failed: %v", tt.s, err)
+		} else if got != tt.want {
+			t.Errorf("CapsString(%q) should be %v, got %v", tt.s, tt.want, got)
+		}
+	}
+
+	if _, err := CapsString("-9223372036854775808"); err == nil {
+		t.Error("CapsString should reject negative numbers")
+	}
+	if got, err := CapsFromValue(1 << 63); err != nil || got != CapAdmin {
+		t.Errorf("CapsFromValue(1 << 63) should be CapAdmin, got %v, %v", got, err)
+	}
+}
+
+func TestCapsJSON(t *testing.T) {
+	data, err := json.Marshal(CapRead | CapAdmin)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != `"CapRead|CapAdmin"` {
+		t.Errorf("Expected \"CapRead|CapAdmin\", got %s", data)
+	}
+
+	var caps Caps
+	if err := json.Unmarshal(data, &caps); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if caps != CapRead|CapAdmin {
+		t.Errorf("Expected CapRead|CapAdmin, got %v", caps)
+	}
+}
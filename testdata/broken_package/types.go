@@ -0,0 +1,20 @@
+package testpkg
+
+import "strings"
+
+// Status represents an enum in a package that doesn't type-check
+type Status int
+
+const (
+	Pending Status = iota
+	Running
+	Done = Missing
+)
+
+func remaining() int {
+	return "none"
+}
+
+func (s Status) String() string {
+	return strings.ToLower(StatusNames()[s])
+}
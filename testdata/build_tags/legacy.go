@@ -0,0 +1,8 @@
+//go:build legacy_enums
+
+package testpkg
+
+// String is the hand written variant used before enumer was adopted
+func (i Status) String() string {
+	return "legacy"
+}
@@ -0,0 +1,37 @@
+package testpkg
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestBuildConstraint(t *testing.T) {
+	data, err := os.ReadFile("status_enumer.go")
+	if err != nil {
+		t.Fatalf("failed to read generated file: %v", err)
+	}
+
+	want := "//go:build !legacy_enums && go1.18\n// +build !legacy_enums,go1.18\n\n"
+	if !strings.HasPrefix(string(data), want) {
+		t.Errorf("generated file should start with %q, got %q", want, string(data)[:len(want)])
+	}
+	if !strings.Contains(string(data), "\npackage testpkg\n") {
+		t.Errorf("generated file should contain the package clause")
+	}
+}
+
+func TestStatusString(t *testing.T) {
+	// Built without the legacy_enums tag, so the generated methods are used
+	if Running.String() != "Running" {
+		t.Errorf("Running.String() should be Running, got %s", Running.String())
+	}
+
+	s, err := StatusString("Failure")
+	if err != nil {
+		t.Errorf("StatusString(Failure) failed: %v", err)
+	}
+	if s != Failure {
+		t.Errorf("StatusString(Failure) should be Failure, got %v", s)
+	}
+}
@@ -0,0 +1,52 @@
+package testpkg
+
+import "testing"
+
+func TestStatusCaseInsensitiveParse(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Status
+	}{
+		{"Pending", Pending},
+		{"pending", Pending},
+		{"PENDING", Pending},
+		{"rUnNiNg", Running},
+		{"success", Success},
+		{"FAILURE", Failure},
+	}
+
+	for _, tt := range tests {
+		s, err := StatusString(tt.input)
+		if err != nil {
+			t.Errorf("Failed to parse %q: %v", tt.input, err)
+			continue
+		}
+		if s != tt.expected {
+			t.Errorf("Parsing %q: expected %v, got %v", tt.input, tt.expected, s)
+		}
+	}
+}
+
+func TestStatusCaseInsensitiveInvalid(t *testing.T) {
+	_, err := StatusString("unknown")
+	if err == nil {
+		t.Error("Expected error for unknown string")
+	}
+}
+
+func TestStatusCaseInsensitiveString(t *testing.T) {
+	// String should still return the canonical casing
+	s, _ := StatusString("SUCCESS")
+	if s.String() != "Success" {
+		t.Errorf("Expected 'Success', got %q", s.String())
+	}
+}
+
+func TestIsValidStatusNameAgreesWithParse(t *testing.T) {
+	for _, input := range []string{"Running", "RUNNING", "running", "Bogus", ""} {
+		_, err := StatusString(input)
+		if valid := IsValidStatusName(input); valid != (err == nil) {
+			t.Errorf("IsValidStatusName(%q) = %v, but StatusString returned %v", input, valid, err)
+		}
+	}
+}
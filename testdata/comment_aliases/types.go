@@ -0,0 +1,12 @@
+package testpkg
+
+// Color accepts legacy spellings when parsing
+type Color int
+
+const (
+	Red   Color = iota // red, crimson, #ff0000
+	Green              // green,  ,lime
+	Blue               // blue
+	Grey               // grey, gray
+	Black
+)
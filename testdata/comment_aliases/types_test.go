@@ -0,0 +1,73 @@
+package testpkg
+
+import "testing"
+
+func TestColorString(t *testing.T) {
+	tests := []struct {
+		value    Color
+		expected string
+	}{
+		{Red, "red"},
+		{Green, "green"},
+		{Blue, "blue"},
+		{Grey, "grey"},
+		{Black, "Black"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.value.String(); got != tt.expected {
+			t.Errorf("%d.String() should be %s, got %s", tt.value, tt.expected, got)
+		}
+	}
+}
+
+func TestColorParseAliases(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Color
+	}{
+		{"red", Red},
+		{"crimson", Red},
+		{"#ff0000", Red},
+		{"#FF0000", Red},
+		{"Crimson", Red},
+		{"green", Green},
+		{"lime", Green},
+		{"gray", Grey},
+		{"GRAY", Grey},
+		{"Black", Black},
+	}
+
+	for _, tt := range tests {
+		got, err := ColorString(tt.input)
+		if err != nil {
+			t.Errorf("ColorString(%q) failed: %v", tt.input, err)
+			continue
+		}
+		if got != tt.expected {
+			t.Errorf("ColorString(%q) should produce %v, got %v", tt.input, tt.expected, got)
+		}
+	}
+}
+
+func TestColorParseInvalid(t *testing.T) {
+	for _, input := range []string{"", "red, crimson, #ff0000", "Red2"} {
+		if _, err := ColorString(input); err == nil {
+			t.Errorf("ColorString(%q) should fail", input)
+		}
+	}
+}
+
+func TestColorNames(t *testing.T) {
+	// Aliases are only used for parsing
+	names := ColorNames()
+	expected := []string{"red", "green", "blue", "grey", "Black"}
+	if len(names) != len(expected) {
+		t.Fatalf("ColorNames should return %v, got %v", expected, names)
+	}
+	for i := range expected {
+		if names[i] != expected[i] {
+			t.Errorf("ColorNames()[%d] should be %s, got %s", i, expected[i], names[i])
+		}
+	}
+}
@@ -0,0 +1,12 @@
+package testpkg
+
+// Code embeds its value in its string form through formatted line comments
+type Code int
+
+const (
+	OK       Code = 0   // ok
+	NotFound Code = 404 // http-%d, e%v
+	Teapot   Code = 418 // http-%d
+	Percent  Code = 500 // 100%% sure
+	Plain    Code = 501
+)
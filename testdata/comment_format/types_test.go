@@ -0,0 +1,48 @@
+package testpkg
+
+import "testing"
+
+func TestCodeFormattedString(t *testing.T) {
+	tests := []struct {
+		value    Code
+		expected string
+	}{
+		{OK, "ok"},
+		{NotFound, "http-404"},
+		{Teapot, "http-418"},
+		{Percent, "100%% sure"},
+		{Plain, "Plain"},
+	}
+
+	for _, tt := range tests {
+		if s := tt.value.String(); s != tt.expected {
+			t.Errorf("Expected %q, got %q", tt.expected, s)
+		}
+	}
+}
+
+func TestCodeFormattedParse(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Code
+	}{
+		{"http-404", NotFound},
+		{"e404", NotFound},
+		{"http-418", Teapot},
+	}
+
+	for _, tt := range tests {
+		val, err := CodeString(tt.input)
+		if err != nil {
+			t.Errorf("CodeString(%q) failed: %v", tt.input, err)
+			continue
+		}
+		if val != tt.expected {
+			t.Errorf("CodeString(%q) = %v, expected %v", tt.input, val, tt.expected)
+		}
+	}
+
+	if _, err := CodeString("http-%d"); err == nil {
+		t.Error("Expected the unformatted comment not to parse")
+	}
+}
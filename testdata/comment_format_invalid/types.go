@@ -0,0 +1,9 @@
+package testpkg
+
+// Code has a line comment formatting its value twice
+type Code int
+
+const (
+	OK       Code = 0   // ok
+	NotFound Code = 404 // http-%d-%d
+)
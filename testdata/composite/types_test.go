@@ -52,6 +52,16 @@ func TestRunStatusParse(t *testing.T) {
 	}
 }
 
+func TestRunStatusParseComposite(t *testing.T) {
+	status, err := RunStatusString("Pending|Completed")
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+	if status != Pending|Completed {
+		t.Errorf("Expected Pending|Completed, got %v", status)
+	}
+}
+
 func TestRunStatusValidation(t *testing.T) {
 	// Named constants should be valid
 	if !Pending.Valid() {
@@ -66,6 +76,42 @@ func TestRunStatusValidation(t *testing.T) {
 	if arbitrary.Valid() {
 		t.Error("Arbitrary combination should not be valid")
 	}
+	if (Pending | Completed).Valid() {
+		t.Error("Flag combined with a named composite should not be valid")
+	}
+
+	// Unknown bits are never valid
+	if (Pending | 64).Valid() {
+		t.Error("Combination with an unknown bit should not be valid")
+	}
+
+	// Composites aren't counted twice
+	if _RunStatusAllBits != Pending|Running|Success|Failure|Skipped {
+		t.Errorf("_RunStatusAllBits should be 31, got %d", _RunStatusAllBits)
+	}
+	if RunStatusAll() != Pending|Running|Success|Failure|Skipped {
+		t.Errorf("RunStatusAll() should be 31, got %d", RunStatusAll())
+	}
+}
+
+func TestRunStatusString(t *testing.T) {
+	tests := []struct {
+		value    RunStatus
+		expected string
+	}{
+		{Pending, "Pending"},
+		{Completed, "Completed"},                   // named composite preferred as a whole
+		{Success | Failure | Skipped, "Completed"}, // same value as Completed
+		{Pending | Success, "Pending|Success"},
+		{Completed | Pending, "Pending|Success|Failure|Skipped"},
+		{Running | 64, "Running|RunStatus(64)"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.value.String(); got != tt.expected {
+			t.Errorf("RunStatus(%d).String(): expected %q, got %q", int(tt.value), tt.expected, got)
+		}
+	}
 }
 
 func TestRunStatusHas(t *testing.T) {
@@ -216,3 +262,17 @@ func TestRunStatusJSON(t *testing.T) {
 		t.Errorf("Unmarshaled Completed should be 28, got %d", status)
 	}
 }
+
+func TestRunStatusFlags(t *testing.T) {
+	// Composites are decomposed into their flags rather than returned whole
+	got := (Completed | Pending).Flags()
+	expected := []RunStatus{Pending, Success, Failure, Skipped}
+	if len(got) != len(expected) {
+		t.Fatalf("(Completed|Pending).Flags() should be %v, got %v", expected, got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("(Completed|Pending).Flags()[%d] should be %v, got %v", i, expected[i], got[i])
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package testpkg
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestStatusCSVRoundTrip(t *testing.T) {
+	row := []Status{Closed, Pending, Active, Closed}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(StatusSliceToStrings(row)); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	w.Flush()
+	if s := buf.String(); s != "Closed,Pending,Active,Closed\n" {
+		t.Errorf("Unexpected record %q", s)
+	}
+
+	record, err := csv.NewReader(&buf).Read()
+	if err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	parsed, err := StatusSliceFromStrings(record)
+	if err != nil {
+		t.Fatalf("StatusSliceFromStrings failed: %v", err)
+	}
+	if !reflect.DeepEqual(parsed, row) {
+		t.Errorf("Expected %v, got %v", row, parsed)
+	}
+}
+
+func TestStatusSliceFromStringsInvalid(t *testing.T) {
+	_, err := StatusSliceFromStrings([]string{"Active", "Closed", "Bogus"})
+	if !errors.Is(err, ErrInvalidStatus) {
+		t.Fatalf("Expected ErrInvalidStatus, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "index 2") || !strings.Contains(err.Error(), "Bogus") {
+		t.Errorf("Expected the index and value in %q", err)
+	}
+}
+
+func TestStatusSliceEmpty(t *testing.T) {
+	if strs := StatusSliceToStrings(nil); len(strs) != 0 {
+		t.Errorf("Expected no strings, got %v", strs)
+	}
+	values, err := StatusSliceFromStrings(nil)
+	if err != nil || len(values) != 0 {
+		t.Errorf("Expected no values, got %v, %v", values, err)
+	}
+}
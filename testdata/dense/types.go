@@ -0,0 +1,24 @@
+package testpkg
+
+// Weekday is contiguous from 1, so String slices a single string
+type Weekday int
+
+const (
+	Monday Weekday = iota + 1
+	Tuesday
+	Wednesday // Wed
+	Thursday
+	Friday
+	Saturday
+	Sunday
+	FirstDay = Monday
+)
+
+// Level is contiguous from 0 with an unsigned underlying type
+type Level uint8
+
+const (
+	Off Level = iota
+	Low
+	High
+)
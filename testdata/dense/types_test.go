@@ -0,0 +1,59 @@
+package testpkg
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestWeekdayStringMatchesMap(t *testing.T) {
+	// The indexed String must give the same result as the map lookup
+	for i := Weekday(-2); i <= 10; i++ {
+		expected, ok := _WeekdayMap[i]
+		if !ok {
+			expected = fmt.Sprintf("Weekday(%d)", int(i))
+		}
+		if got := i.String(); got != expected {
+			t.Errorf("Weekday(%d).String() should be %q, got %q", int(i), expected, got)
+		}
+	}
+}
+
+func TestWeekdayString(t *testing.T) {
+	if Monday.String() != "Monday" {
+		t.Errorf("Monday.String() should be Monday, got %s", Monday.String())
+	}
+	if Wednesday.String() != "Wed" {
+		t.Errorf("Wednesday.String() should be Wed, got %s", Wednesday.String())
+	}
+	if FirstDay.String() != "Monday" {
+		t.Errorf("FirstDay.String() should be Monday, got %s", FirstDay.String())
+	}
+	if Weekday(0).String() != "Weekday(0)" {
+		t.Errorf("Weekday(0).String() should be Weekday(0), got %s", Weekday(0).String())
+	}
+}
+
+func TestLevelStringMatchesMap(t *testing.T) {
+	for i := 0; i <= 255; i++ {
+		l := Level(i)
+		expected, ok := _LevelMap[l]
+		if !ok {
+			expected = fmt.Sprintf("Level(%d)", i)
+		}
+		if got := l.String(); got != expected {
+			t.Errorf("Level(%d).String() should be %q, got %q", i, expected, got)
+		}
+	}
+}
+
+func BenchmarkWeekdayString(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = Weekday(i%7 + 1).String()
+	}
+}
+
+func BenchmarkWeekdayStringMap(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = _WeekdayMap[Weekday(i%7+1)]
+	}
+}
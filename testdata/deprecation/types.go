@@ -0,0 +1,28 @@
+package testpkg
+
+// Status represents an enum with retired values
+type Status int
+
+const (
+	Pending Status = iota
+
+	// Queued was the name of Pending in older versions.
+	//
+	// Deprecated: use Pending.
+	Queued = Pending
+
+	// Started is the old name of Running.
+	//
+	// Deprecated: use Running.
+	Started Status = 1
+
+	// Running is a task in progress
+	Running = Started
+
+	// Failed is no longer reported.
+	//
+	// Deprecated: tasks are retried until they succeed.
+	Failed Status = 2
+
+	Done Status = 3
+)
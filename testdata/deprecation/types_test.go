@@ -0,0 +1,39 @@
+package testpkg
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStatusActiveValues(t *testing.T) {
+	// Running keeps value 1 offered, although Started is deprecated
+	expected := []Status{Pending, Running, Done}
+	active := StatusActiveValues()
+	if len(active) != len(expected) {
+		t.Fatalf("Expected %d active values, got %d: %v", len(expected), len(active), active)
+	}
+	for i := range expected {
+		if active[i] != expected[i] {
+			t.Errorf("Index %d: expected %v, got %v", i, expected[i], active[i])
+		}
+	}
+}
+
+func TestStatusDeprecatedStillParses(t *testing.T) {
+	s, err := StatusString("Failed")
+	if err != nil || s != Failed {
+		t.Errorf("Expected Failed, got %v, %v", s, err)
+	}
+
+	var v Status
+	if err := json.Unmarshal([]byte(`"Failed"`), &v); err != nil || v != Failed {
+		t.Errorf("Expected Failed to unmarshal, got %v, %v", v, err)
+	}
+
+	if len(StatusValues()) != 4 {
+		t.Errorf("Expected StatusValues to include deprecated values, got %v", StatusValues())
+	}
+	if !Failed.Valid() {
+		t.Error("Failed should still be valid")
+	}
+}
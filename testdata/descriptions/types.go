@@ -0,0 +1,26 @@
+package testpkg
+
+// Plan is a subscription plan
+type Plan int
+
+const (
+	// Free is the default plan, with "basic" limits.
+	Free Plan = iota // free
+
+	// Pro adds priority support.
+	// Billed monthly.
+	Pro
+
+	Enterprise // enterprise
+
+	// Legacy is an old name for Pro.
+	Legacy = Pro
+)
+
+// Region is a deployment region
+type Region int
+
+// EU is hosted in Frankfurt.
+const EU Region = 1
+
+const US Region = 2
@@ -0,0 +1,40 @@
+package testpkg
+
+import "testing"
+
+func TestPlanDescription(t *testing.T) {
+	tests := []struct {
+		value    Plan
+		expected string
+	}{
+		{Free, `Free is the default plan, with "basic" limits.`},
+		{Pro, "Pro adds priority support.\nBilled monthly."},
+		{Enterprise, ""},
+		{Plan(42), ""},
+	}
+
+	for _, tt := range tests {
+		if got := tt.value.Description(); got != tt.expected {
+			t.Errorf("%d.Description() should be %q, got %q", tt.value, tt.expected, got)
+		}
+	}
+}
+
+func TestPlanStringUnaffected(t *testing.T) {
+	// Doc comments are independent of the line comments used by -linecomment
+	if Free.String() != "free" {
+		t.Errorf("Free.String() should be free, got %s", Free.String())
+	}
+	if Pro.String() != "Pro" {
+		t.Errorf("Pro.String() should be Pro, got %s", Pro.String())
+	}
+}
+
+func TestRegionDescription(t *testing.T) {
+	if got := EU.Description(); got != "EU is hosted in Frankfurt." {
+		t.Errorf("EU.Description() should come from the declaration doc, got %q", got)
+	}
+	if got := US.Description(); got != "" {
+		t.Errorf("US.Description() should be empty, got %q", got)
+	}
+}
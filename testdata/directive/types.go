@@ -0,0 +1,13 @@
+package testpkg
+
+// Status represents an enum configured by a directive, with the transform
+// overridden on the command line
+//
+//enumer:json,sql transform=snake trimprefix="Status,Legacy"
+type Status int
+
+const (
+	StatusPending Status = iota
+	StatusInProgress
+	LegacyDone
+)
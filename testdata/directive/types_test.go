@@ -0,0 +1,38 @@
+package testpkg
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+)
+
+func TestStatusDirectiveTrimPrefix(t *testing.T) {
+	expected := []string{"pending", "inprogress", "done"}
+	for i, name := range StatusNames() {
+		if name != expected[i] {
+			t.Errorf("Name %d: expected %q, got %q", i, expected[i], name)
+		}
+	}
+}
+
+func TestStatusDirectiveJSON(t *testing.T) {
+	data, err := json.Marshal(StatusInProgress)
+	if err != nil {
+		t.Fatalf("Failed to marshal: %v", err)
+	}
+	if string(data) != `"inprogress"` {
+		t.Errorf("Expected \"inprogress\", got %s", data)
+	}
+}
+
+func TestStatusDirectiveSQL(t *testing.T) {
+	var _ driver.Valuer = LegacyDone
+
+	var s Status
+	if err := s.Scan("done"); err != nil {
+		t.Fatalf("Failed to scan: %v", err)
+	}
+	if s != LegacyDone {
+		t.Errorf("Expected LegacyDone, got %v", s)
+	}
+}
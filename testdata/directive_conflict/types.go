@@ -0,0 +1,19 @@
+package testpkg
+
+// Color has a directive, but Status doesn't, so they can't share a file
+//
+//enumer:json
+type Color int
+
+const (
+	Red Color = iota
+	Green
+)
+
+// Status represents a task state
+type Status int
+
+const (
+	Pending Status = iota
+	Done
+)
@@ -0,0 +1,10 @@
+package testpkg
+
+// Color represents an enum where trimming the prefix produces a duplicate
+type Color int
+
+const (
+	Red Color = iota
+	Green
+	ColorRed
+)
@@ -0,0 +1,21 @@
+package testpkg
+
+// Path is string based, with values that need escaping in Go source
+type Path string
+
+const (
+	Root    Path = `C:\`
+	Windows Path = `C:\dir\sub`
+	Quoted  Path = `say "hi"`
+	Lines   Path = "line\nbreak"
+)
+
+// Quote takes its strings from line comments containing quotes and
+// backslashes
+type Quote int
+
+const (
+	Double    Quote = iota // "double"
+	Backslash              // back\slash
+	Mixed                  // it's a \"mix\"
+)
@@ -0,0 +1,55 @@
+package testpkg
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPathEscaped(t *testing.T) {
+	for _, p := range []Path{Root, Windows, Quoted, Lines} {
+		if !p.Valid() {
+			t.Errorf("%q should be valid", string(p))
+		}
+		got, err := PathString(string(p))
+		if err != nil || got != p {
+			t.Errorf("PathString(%q) should be %q, got %q, %v", string(p), string(p), string(got), err)
+		}
+	}
+	if got, err := PathString(`c:\DIR\sub`); err != nil || got != Windows {
+		t.Errorf("PathString should ignore case, got %q, %v", string(got), err)
+	}
+}
+
+func TestQuoteEscaped(t *testing.T) {
+	tests := []struct {
+		value    Quote
+		expected string
+	}{
+		{Double, `"double"`},
+		{Backslash, `back\slash`},
+		{Mixed, `it's a \"mix\"`},
+	}
+
+	for _, tt := range tests {
+		if got := tt.value.String(); got != tt.expected {
+			t.Errorf("String() of %d should be %s, got %s", int(tt.value), tt.expected, got)
+		}
+		if got, err := QuoteString(tt.expected); err != nil || got != tt.value {
+			t.Errorf("QuoteString(%s) should be %d, got %d, %v", tt.expected, tt.value, got, err)
+		}
+	}
+}
+
+func TestQuoteEscapedJSON(t *testing.T) {
+	data, err := json.Marshal(Mixed)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != `"it's a \\\"mix\\\""` {
+		t.Errorf("Unexpected JSON %s", data)
+	}
+	var q Quote
+	if err := json.Unmarshal(data, &q); err != nil || q != Mixed {
+		t.Errorf("Unmarshal of %s should give Mixed, got %d, %v", data, q, err)
+	}
+}
@@ -0,0 +1,31 @@
+package testpkg
+
+// Status represents an enum with hand-written String and Valid methods
+type Status int
+
+const (
+	Pending Status = iota
+	Running
+	Done
+	Legacy
+)
+
+// String returns the status in lower case
+func (s Status) String() string {
+	switch s {
+	case Pending:
+		return "pending"
+	case Running:
+		return "running"
+	case Done:
+		return "done"
+	case Legacy:
+		return "legacy"
+	}
+	return "unknown"
+}
+
+// Valid reports whether s is a status that's still in use
+func (s Status) Valid() bool {
+	return s >= Pending && s <= Done
+}
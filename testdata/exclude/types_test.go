@@ -0,0 +1,43 @@
+package testpkg
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStatusHandWritten(t *testing.T) {
+	if got := Running.String(); got != "running" {
+		t.Errorf("Running.String() should use the hand-written method, got %q", got)
+	}
+	if Legacy.Valid() {
+		t.Error("Legacy.Valid() should use the hand-written method")
+	}
+	if _, err := StatusFromValue(3); err == nil {
+		t.Error("StatusFromValue should use the hand-written Valid")
+	}
+	if got := string(Done.AppendString(nil)); got != "done" {
+		t.Errorf("AppendString should use the hand-written String, got %q", got)
+	}
+}
+
+func TestStatusParseHelpers(t *testing.T) {
+	// Parsing still uses the generated names
+	s, err := StatusString("Running")
+	if err != nil || s != Running {
+		t.Errorf("StatusString(\"Running\") should be Running, got %v, %v", s, err)
+	}
+	if !IsValidStatusName("Legacy") {
+		t.Error("IsValidStatusName(\"Legacy\") should be true")
+	}
+	if n := len(StatusValues()); n != 4 {
+		t.Errorf("StatusValues() should have 4 values, got %d", n)
+	}
+
+	data, err := json.Marshal(Done)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != `"done"` {
+		t.Errorf("Expected \"done\", got %s", data)
+	}
+}
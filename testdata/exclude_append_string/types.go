@@ -0,0 +1,23 @@
+package testpkg
+
+// Status represents an enum with a hand-written AppendString method
+type Status int
+
+const (
+	Pending Status = iota
+	Running
+	Done
+)
+
+// AppendString appends the status in upper case, and "?" for unknown ones
+func (s Status) AppendString(b []byte) []byte {
+	switch s {
+	case Pending:
+		return append(b, "PENDING"...)
+	case Running:
+		return append(b, "RUNNING"...)
+	case Done:
+		return append(b, "DONE"...)
+	}
+	return append(b, '?')
+}
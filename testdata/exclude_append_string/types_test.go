@@ -0,0 +1,20 @@
+package testpkg
+
+import "testing"
+
+func TestStatusHandWrittenAppendString(t *testing.T) {
+	if got := string(Running.AppendString([]byte("status: "))); got != "status: RUNNING" {
+		t.Errorf("Expected \"status: RUNNING\", got %q", got)
+	}
+	if got := string(Status(9).AppendString(nil)); got != "?" {
+		t.Errorf("Expected \"?\", got %q", got)
+	}
+
+	// The generated methods still use the generated names
+	if got := Running.String(); got != "Running" {
+		t.Errorf("Expected \"Running\", got %q", got)
+	}
+	if v, err := StatusString("Done"); err != nil || v != Done {
+		t.Errorf("Expected Done, got %v, %v", v, err)
+	}
+}
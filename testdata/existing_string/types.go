@@ -0,0 +1,38 @@
+package testpkg
+
+import "strings"
+
+// Status has a hand-written String method, so enumer doesn't generate one
+type Status int
+
+const (
+	Pending Status = iota
+	Active
+	Closed
+)
+
+// String returns the status in capitals
+func (s Status) String() string {
+	switch s {
+	case Pending:
+		return "PENDING"
+	case Active:
+		return "ACTIVE"
+	case Closed:
+		return "CLOSED"
+	}
+	return "UNKNOWN"
+}
+
+// Lower returns the status in lower case
+func (s Status) Lower() string {
+	return strings.ToLower(s.String())
+}
+
+// Level doesn't have a String method, so it's generated as usual
+type Level int
+
+const (
+	Low Level = iota
+	High
+)
@@ -0,0 +1,41 @@
+package testpkg
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStatusExistingString(t *testing.T) {
+	if s := Active.String(); s != "ACTIVE" {
+		t.Errorf("Expected the hand-written String, got %q", s)
+	}
+	if s := Status(42).String(); s != "UNKNOWN" {
+		t.Errorf("Expected UNKNOWN, got %q", s)
+	}
+}
+
+func TestStatusExistingStringJSON(t *testing.T) {
+	data, err := json.Marshal(Closed)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != `"CLOSED"` {
+		t.Errorf("Expected \"CLOSED\", got %s", data)
+	}
+}
+
+func TestStatusParse(t *testing.T) {
+	s, err := StatusString("Active")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if s != Active {
+		t.Errorf("Expected Active, got %v", s)
+	}
+}
+
+func TestLevelGeneratedString(t *testing.T) {
+	if s := High.String(); s != "High" {
+		t.Errorf("Expected High, got %q", s)
+	}
+}
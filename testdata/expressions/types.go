@@ -0,0 +1,16 @@
+package testpkg
+
+// Size is a flag enum whose values are written as arbitrary constant expressions
+type Size uint64
+
+const (
+	Small  Size = 1 << iota // 1
+	Medium                  // 2
+	Quad   Size = 2 * 2     // 4
+	KiB    Size = 1024.0    // 1 << 10, written as a float literal
+	Large  Size = 1 << 20
+	Huge   Size = Large << 20         // 1 << 40
+	Top    Size = 1 << (64 - 1)       // 1 << 63
+	Pair        = Small + Medium      // 3
+	Spread      = (Small | Quad) << 1 // Medium | 8, where 8 isn't a flag
+)
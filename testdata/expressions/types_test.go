@@ -0,0 +1,79 @@
+package testpkg
+
+import "testing"
+
+func TestSizeValues(t *testing.T) {
+	tests := []struct {
+		value    Size
+		expected uint64
+	}{
+		{Small, 1},
+		{Medium, 2},
+		{Quad, 4},
+		{KiB, 1 << 10},
+		{Large, 1 << 20},
+		{Huge, 1 << 40},
+		{Top, 1 << 63},
+		{Pair, 3},
+		{Spread, 10},
+	}
+
+	for _, tt := range tests {
+		if uint64(tt.value) != tt.expected {
+			t.Errorf("%s should be %d, got %d", tt.value, tt.expected, uint64(tt.value))
+		}
+		if !tt.value.Valid() {
+			t.Errorf("%s should be valid", tt.value)
+		}
+		got, err := SizeString(tt.value.String())
+		if err != nil || got != tt.value {
+			t.Errorf("SizeString(%s) should produce %d, got %d, %v", tt.value, tt.value, got, err)
+		}
+	}
+}
+
+func TestSizeFlags(t *testing.T) {
+	// Only the single bit constants are flags, however they were written
+	expected := []Size{Small, Medium, Quad, KiB, Large, Huge, Top}
+	all := SizeAll().Flags()
+	if len(all) != len(expected) {
+		t.Fatalf("SizeAll().Flags() should be %v, got %v", expected, all)
+	}
+	for i := range expected {
+		if all[i] != expected[i] {
+			t.Errorf("flag %d should be %s, got %s", i, expected[i], all[i])
+		}
+	}
+
+	if got := Spread.Flags(); len(got) != 1 || got[0] != Medium {
+		t.Errorf("Spread.Flags() should be [Medium], got %v", got)
+	}
+}
+
+func TestSizeString(t *testing.T) {
+	tests := []struct {
+		value    Size
+		expected string
+	}{
+		{Small | Top, "Small|Top"},
+		{KiB | Huge, "KiB|Huge"},
+		{Small | Medium, "Pair"},
+		{Medium | 8, "Spread"},
+		{Quad | 8, "Quad|Size(8)"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.value.String(); got != tt.expected {
+			t.Errorf("%d.String() should be %s, got %s", uint64(tt.value), tt.expected, got)
+		}
+	}
+}
+
+func TestSizeValid(t *testing.T) {
+	if (Quad | 8).Valid() {
+		t.Error("Quad|8 should not be valid, as 8 isn't a flag")
+	}
+	if !(Top | Huge | Small).Valid() {
+		t.Error("Top|Huge|Small should be valid")
+	}
+}
@@ -0,0 +1,10 @@
+package testpkg
+
+// Level is set from the command line
+type Level int
+
+const (
+	Low Level = iota
+	Medium
+	High
+)
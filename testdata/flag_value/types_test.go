@@ -0,0 +1,57 @@
+package testpkg
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"strings"
+	"testing"
+)
+
+var _ flag.Value = (*Level)(nil)
+
+// The pflag.Value interface
+var _ interface {
+	String() string
+	Set(string) error
+	Type() string
+} = (*Level)(nil)
+
+func TestLevelFlag(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	level := Medium
+	fs.Var(&level, "level", "the level")
+
+	if err := fs.Parse([]string{"-level=High"}); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if level != High {
+		t.Errorf("-level=High should set High, got %v", level)
+	}
+}
+
+func TestLevelFlagInvalid(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	level := Medium
+	fs.Var(&level, "level", "the level")
+
+	err := fs.Parse([]string{"-level=Extreme"})
+	if err == nil || !strings.Contains(err.Error(), "Extreme is not a valid Level") {
+		t.Errorf("-level=Extreme should fail, got %v", err)
+	}
+	if level != Medium {
+		t.Errorf("a failed Set should leave the value unchanged, got %v", level)
+	}
+
+	if err := level.Set("Extreme"); !errors.Is(err, ErrInvalidLevel) {
+		t.Errorf("Set(Extreme) should fail with ErrInvalidLevel, got %v", err)
+	}
+}
+
+func TestLevelType(t *testing.T) {
+	var level Level
+	if level.Type() != "Level" {
+		t.Errorf("Type() should be Level, got %s", level.Type())
+	}
+}
@@ -2,6 +2,7 @@ package testpkg
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 )
 
@@ -40,11 +41,37 @@ func TestPermissionCombinations(t *testing.T) {
 	if readWrite.Valid() {
 		t.Error("Combined Read|Write should not be valid (not a named constant)")
 	}
+	if p, err := PermissionString("Read|Write"); err != nil || p.Valid() {
+		t.Errorf("Read|Write should parse to a value that isn't valid, got %v, %v", p, err)
+	}
 
 	// Individual flags should be valid
 	if !Read.Valid() {
 		t.Error("Read should be valid")
 	}
+
+	// Bits that don't belong to a flag are not valid
+	if (Read | 16).Valid() {
+		t.Error("Read|16 should not be valid (16 is not a known flag)")
+	}
+	if Permission(-1).Valid() {
+		t.Error("Permission(-1) should not be valid")
+	}
+	if _PermissionAllBits != Read|Write|Execute|Delete {
+		t.Errorf("_PermissionAllBits should be 15, got %d", _PermissionAllBits)
+	}
+}
+
+func TestPermissionAll(t *testing.T) {
+	if PermissionAll() != Read|Write|Execute|Delete {
+		t.Errorf("PermissionAll() should be Read|Write|Execute|Delete, got %d", PermissionAll())
+	}
+	if !PermissionAll().HasAll(PermissionValues()...) {
+		t.Error("PermissionAll() should have every flag set")
+	}
+	if p := (Read | Execute).Clear(PermissionAll()); p != 0 {
+		t.Errorf("clearing PermissionAll() should leave no flags, got %v", p)
+	}
 }
 
 func TestPermissionHas(t *testing.T) {
@@ -96,3 +123,128 @@ func TestPermissionJSON(t *testing.T) {
 		t.Errorf("Expected \"Write\", got %s", data)
 	}
 }
+
+func TestPermissionString(t *testing.T) {
+	tests := []struct {
+		value    Permission
+		expected string
+	}{
+		{Read, "Read"},
+		{Read | Write, "Read|Write"},
+		{Read | Execute | Delete, "Read|Execute|Delete"},
+		{Write | 16, "Write|Permission(16)"},
+		{Permission(32), "Permission(32)"},
+		{Permission(0), "Permission(0)"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.value.String(); got != tt.expected {
+			t.Errorf("Permission(%d).String(): expected %q, got %q", int(tt.value), tt.expected, got)
+		}
+	}
+}
+
+func TestPermissionParseComposite(t *testing.T) {
+	p, err := PermissionString("Read|Execute")
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+	if p != Read|Execute {
+		t.Errorf("Expected Read|Execute, got %v", p)
+	}
+
+	// Whitespace around each flag name is ignored
+	p, err = PermissionString("Write | Delete")
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+	if p != Write|Delete {
+		t.Errorf("Expected Write|Delete, got %v", p)
+	}
+
+	_, err = PermissionString("Read|Bogus")
+	if err == nil {
+		t.Fatal("Expected error for unknown flag")
+	}
+	if !strings.Contains(err.Error(), "Bogus") {
+		t.Errorf("Error should cite the bad token, got: %v", err)
+	}
+}
+
+func TestPermissionStringRoundTrip(t *testing.T) {
+	for _, p := range []Permission{0, Read, Read | Write, Write | Execute | Delete} {
+		parsed, err := PermissionString(p.String())
+		if err != nil || parsed != p {
+			t.Errorf("Round trip of %v failed: got %v, %v", p, parsed, err)
+		}
+	}
+	if !IsValidPermissionName("Permission(0)") {
+		t.Error("IsValidPermissionName should accept the empty set")
+	}
+
+	// Only the empty set parses in the form of a number
+	for _, s := range []string{"Permission(16)", "Permission(1)", "0"} {
+		if _, err := PermissionString(s); err == nil {
+			t.Errorf("PermissionString(%q) should fail", s)
+		}
+	}
+}
+
+func TestPermissionJSONRoundTrip(t *testing.T) {
+	// Values built with Set decode as they were encoded
+	for _, p := range []Permission{Read.Set(Write), Write.Set(Execute, Delete), 0} {
+		data, err := json.Marshal(p)
+		if err != nil {
+			t.Fatalf("Marshal of %v failed: %v", p, err)
+		}
+		var got Permission
+		if err := json.Unmarshal(data, &got); err != nil || got != p {
+			t.Errorf("Unmarshal of %s should give %d, got %d, %v", data, p, got, err)
+		}
+	}
+}
+
+func TestPermissionZeroJSON(t *testing.T) {
+	// A zero field of a struct decodes as it was encoded
+	type file struct {
+		Perm Permission `json:"perm"`
+	}
+	data, err := json.Marshal(file{})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != `{"perm":"Permission(0)"}` {
+		t.Errorf("Unexpected JSON %s", data)
+	}
+	f := file{Perm: Read}
+	if err := json.Unmarshal(data, &f); err != nil || f.Perm != 0 {
+		t.Errorf("Unmarshal of %s should give 0, got %v, %v", data, f.Perm, err)
+	}
+}
+
+func TestPermissionFlags(t *testing.T) {
+	tests := []struct {
+		value    Permission
+		expected []Permission
+	}{
+		{Read | Execute, []Permission{Read, Execute}},
+		{Delete | Read, []Permission{Read, Delete}},
+		{Write, []Permission{Write}},
+		{Write | 64, []Permission{Write}}, // unknown bits are ignored
+		{0, nil},
+	}
+
+	for _, tt := range tests {
+		got := tt.value.Flags()
+		if len(got) != len(tt.expected) {
+			t.Errorf("%d.Flags() should be %v, got %v", tt.value, tt.expected, got)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.expected[i] {
+				t.Errorf("%d.Flags() should be %v, got %v", tt.value, tt.expected, got)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package testpkg
+
+// Status is trimmed, so its strings differ from its constant names
+type Status int
+
+const (
+	StatusPending Status = iota
+	StatusSuccess
+	StatusFailure
+	StatusFailed = StatusFailure
+)
+
+// Color is string based
+type Color string
+
+const (
+	Red  Color = "red"
+	Blue Color = "blue"
+)
@@ -0,0 +1,39 @@
+package testpkg
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestStatusGoString(t *testing.T) {
+	tests := []struct {
+		value    Status
+		expected string
+	}{
+		{StatusSuccess, "StatusSuccess"},
+		{StatusFailed, "StatusFailure"},
+		{Status(99), "Status(99)"},
+		{Status(-1), "Status(-1)"},
+	}
+
+	for _, tt := range tests {
+		if got := fmt.Sprintf("%#v", tt.value); got != tt.expected {
+			t.Errorf("%%#v of %d should be %q, got %q", int(tt.value), tt.expected, got)
+		}
+	}
+	if got := StatusSuccess.String(); got != "Success" {
+		t.Errorf("StatusSuccess.String() should be \"Success\", got %q", got)
+	}
+}
+
+func TestColorGoString(t *testing.T) {
+	if got := fmt.Sprintf("%#v", Red); got != "Red" {
+		t.Errorf("%%#v of Red should be \"Red\", got %q", got)
+	}
+	if got := fmt.Sprintf("%#v", Color("green")); got != `Color("green")` {
+		t.Errorf("%%#v of Color(\"green\") should be `Color(\"green\")`, got %q", got)
+	}
+	if got := fmt.Sprintf("%#v", []Status{StatusPending}); got != "[]testpkg.Status{StatusPending}" {
+		t.Errorf("%%#v of a slice should use GoString, got %q", got)
+	}
+}
@@ -0,0 +1,7 @@
+package testpkg
+
+// Legacy colors are declared in their own file and aren't generated
+const (
+	Magenta Color = iota + 10
+	Cyan
+)
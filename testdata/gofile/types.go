@@ -0,0 +1,13 @@
+package testpkg
+
+//go:generate enumer -type=Color -gofile=$GOFILE
+
+// Color has constants spread over two files, but only those in this file
+// are generated
+type Color int
+
+const (
+	Red Color = iota
+	Green
+	Blue
+)
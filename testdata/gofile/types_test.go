@@ -0,0 +1,27 @@
+package testpkg
+
+import "testing"
+
+func TestColorValues(t *testing.T) {
+	values := ColorValues()
+	if len(values) != 3 {
+		t.Fatalf("Expected 3 values, got %d", len(values))
+	}
+	for i, expected := range []Color{Red, Green, Blue} {
+		if values[i] != expected {
+			t.Errorf("Expected %v at %d, got %v", expected, i, values[i])
+		}
+	}
+}
+
+func TestColorOtherFile(t *testing.T) {
+	if Cyan.Valid() {
+		t.Error("Expected Cyan from another file not to be valid")
+	}
+	if _, err := ColorString("Magenta"); err == nil {
+		t.Error("Expected error parsing Magenta from another file")
+	}
+	if s := Cyan.String(); s != "Color(11)" {
+		t.Errorf("Expected Color(11), got %q", s)
+	}
+}
@@ -0,0 +1,10 @@
+package testpkg
+
+// Role is exposed as a GraphQL enum
+type Role int
+
+const (
+	Viewer Role = iota // VIEWER
+	Editor             // EDITOR
+	Admin              // ADMIN
+)
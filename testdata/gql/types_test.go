@@ -0,0 +1,49 @@
+package testpkg
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+// The interfaces gqlgen uses to bind enums
+type graphqlMarshaler interface {
+	MarshalGQL(w io.Writer)
+}
+
+type graphqlUnmarshaler interface {
+	UnmarshalGQL(v any) error
+}
+
+var (
+	_ graphqlMarshaler   = Viewer
+	_ graphqlUnmarshaler = (*Role)(nil)
+)
+
+func TestRoleMarshalGQL(t *testing.T) {
+	var buf bytes.Buffer
+	Editor.MarshalGQL(&buf)
+	if buf.String() != `"EDITOR"` {
+		t.Errorf("MarshalGQL should write \"EDITOR\", got %s", buf.String())
+	}
+}
+
+func TestRoleUnmarshalGQL(t *testing.T) {
+	var r Role
+	if err := r.UnmarshalGQL("ADMIN"); err != nil {
+		t.Fatalf("UnmarshalGQL(ADMIN) failed: %v", err)
+	}
+	if r != Admin {
+		t.Errorf("UnmarshalGQL(ADMIN) should produce Admin, got %v", r)
+	}
+}
+
+func TestRoleUnmarshalGQLInvalid(t *testing.T) {
+	var r Role
+	for _, v := range []any{"OWNER", 2, nil} {
+		if err := r.UnmarshalGQL(v); !errors.Is(err, ErrInvalidRole) {
+			t.Errorf("UnmarshalGQL(%#v) should fail with ErrInvalidRole, got %v", v, err)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package testpkg
+
+// Status is generated with prefixed identifiers
+type Status int
+
+const (
+	Pending Status = iota
+	Active
+	Closed
+)
+
+// StatusValues is hand-written, and would clash with the generated function
+// without a prefix
+func StatusValues() []string {
+	return []string{"pending", "active", "closed"}
+}
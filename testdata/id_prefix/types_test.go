@@ -0,0 +1,46 @@
+package testpkg
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestPrefixedFunctions(t *testing.T) {
+	if values := enumStatusValues(); len(values) != 3 || values[2] != Closed {
+		t.Errorf("Unexpected values %v", values)
+	}
+	if names := StatusValues(); names[0] != "pending" {
+		t.Errorf("Expected the hand-written StatusValues, got %v", names)
+	}
+	if n := enumStatusLen(); n != 3 {
+		t.Errorf("Expected 3 values, got %d", n)
+	}
+
+	val, err := enumStatusString("Active")
+	if err != nil || val != Active {
+		t.Errorf("Expected Active, got %v, %v", val, err)
+	}
+	if _, err := enumStatusString("Bogus"); !errors.Is(err, enumErrInvalidStatus) {
+		t.Errorf("Expected enumErrInvalidStatus, got %v", err)
+	}
+	if val := enumStatusOrDefault("Bogus", Closed); val != Closed {
+		t.Errorf("Expected Closed, got %v", val)
+	}
+}
+
+func TestPrefixedMethodsUnchanged(t *testing.T) {
+	if s := Active.String(); s != "Active" {
+		t.Errorf("Expected Active, got %q", s)
+	}
+
+	data, err := json.Marshal(Closed)
+	if err != nil || string(data) != `"Closed"` {
+		t.Errorf("Expected \"Closed\", got %s, %v", data, err)
+	}
+
+	var n enumNullStatus
+	if err := n.Scan("Pending"); err != nil || !n.Valid || n.Status != Pending {
+		t.Errorf("Unexpected NullStatus %+v, %v", n, err)
+	}
+}
@@ -0,0 +1,10 @@
+package testpkg
+
+// Status represents an enum whose unnamed values print in a custom form
+type Status int
+
+const (
+	Pending Status = iota
+	Running
+	Done
+)
@@ -0,0 +1,32 @@
+package testpkg
+
+import "testing"
+
+func TestStatusInvalidFormat(t *testing.T) {
+	if got := Status(99).String(); got != "<invalid Status: 99>" {
+		t.Errorf("Status(99).String() should be \"<invalid Status: 99>\", got %q", got)
+	}
+	if got := Status(-3).String(); got != "<invalid Status: -3>" {
+		t.Errorf("Status(-3).String() should be \"<invalid Status: -3>\", got %q", got)
+	}
+	if got := Running.String(); got != "Running" {
+		t.Errorf("Running.String() should be \"Running\", got %q", got)
+	}
+}
+
+func TestStatusInvalidFormatRoundTrip(t *testing.T) {
+	for _, s := range []Status{Running, Status(99), Status(-3)} {
+		got, err := StatusString(s.String())
+		if err != nil {
+			t.Errorf("StatusString(%q) failed: %v", s.String(), err)
+		} else if got != s {
+			t.Errorf("StatusString(%q) should be %d, got %d", s.String(), s, got)
+		}
+	}
+
+	for _, s := range []string{"Status(99)", "<invalid Status: >", "<invalid Status: 99", "99"} {
+		if _, err := StatusString(s); err == nil {
+			t.Errorf("StatusString(%q) should fail", s)
+		}
+	}
+}
@@ -0,0 +1,9 @@
+package testpkg
+
+// Config is a struct, so it can't be an enum
+type Config struct {
+	Name string
+}
+
+// DefaultConfig is a value of the type, not a constant
+var DefaultConfig = Config{Name: "default"}
@@ -0,0 +1,11 @@
+package testpkg
+
+// Status is iterated with range over func
+type Status int
+
+const (
+	Pending Status = iota
+	Running
+	Done
+	Finished = Done
+)
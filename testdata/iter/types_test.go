@@ -0,0 +1,39 @@
+package testpkg
+
+import (
+	"iter"
+	"testing"
+)
+
+var _ iter.Seq[Status] = StatusAll
+
+func TestStatusAll(t *testing.T) {
+	var got []Status
+	for v := range StatusAll {
+		got = append(got, v)
+	}
+
+	expected := []Status{Pending, Running, Done}
+	if len(got) != len(expected) {
+		t.Fatalf("StatusAll should yield %v, got %v", expected, got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("value %d should be %v, got %v", i, expected[i], got[i])
+		}
+	}
+}
+
+func TestStatusAllEarlyExit(t *testing.T) {
+	var got []Status
+	for v := range StatusAll {
+		got = append(got, v)
+		if v == Running {
+			break
+		}
+	}
+
+	if len(got) != 2 || got[1] != Running {
+		t.Errorf("StatusAll should stop after Running, got %v", got)
+	}
+}
@@ -0,0 +1,11 @@
+package testpkg
+
+// Status is sent by a third party API as either a string or a number
+type Status int
+
+const (
+	Pending Status = iota
+	Running
+	Success
+	Failure
+)
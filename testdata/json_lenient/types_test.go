@@ -0,0 +1,53 @@
+package testpkg
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+type event struct {
+	Status Status `json:"status"`
+}
+
+func TestStatusMarshalJSON(t *testing.T) {
+	// Marshaling still uses the string representation
+	data, err := json.Marshal(event{Status: Success})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != `{"status":"Success"}` {
+		t.Errorf("Marshal should produce the string, got %s", data)
+	}
+}
+
+func TestStatusUnmarshalJSON(t *testing.T) {
+	for _, input := range []string{`{"status":"Success"}`, `{"status":2}`} {
+		var got event
+		if err := json.Unmarshal([]byte(input), &got); err != nil {
+			t.Errorf("Unmarshal(%s) failed: %v", input, err)
+			continue
+		}
+		if got.Status != Success {
+			t.Errorf("Unmarshal(%s) should produce Success, got %v", input, got.Status)
+		}
+	}
+}
+
+func TestStatusUnmarshalJSONInvalid(t *testing.T) {
+	invalid := []string{
+		`{"status":"Unknown"}`,
+		`{"status":"2"}`,
+		`{"status":7}`,
+		`{"status":1.5}`,
+		`{"status":null}`,
+		`{"status":[2]}`,
+	}
+
+	for _, input := range invalid {
+		var got event
+		if err := json.Unmarshal([]byte(input), &got); !errors.Is(err, ErrInvalidStatus) {
+			t.Errorf("Unmarshal(%s) should fail with ErrInvalidStatus, got %v, %v", input, got.Status, err)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package testpkg
+
+// Priority is sent to clients as a number
+type Priority uint8
+
+const (
+	Low    Priority = 1
+	Medium Priority = 5
+	High   Priority = 10
+)
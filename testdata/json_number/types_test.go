@@ -0,0 +1,75 @@
+package testpkg
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type task struct {
+	Priority Priority `json:"priority"`
+}
+
+func TestPriorityMarshalJSON(t *testing.T) {
+	data, err := json.Marshal(task{Priority: Medium})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != `{"priority":5}` {
+		t.Errorf("Marshal should produce the number, got %s", data)
+	}
+}
+
+func TestPriorityUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Priority
+	}{
+		{`{"priority":1}`, Low},
+		{`{"priority":10}`, High},
+		{`{"priority":"Medium"}`, Medium},
+	}
+
+	for _, tt := range tests {
+		var got task
+		if err := json.Unmarshal([]byte(tt.input), &got); err != nil {
+			t.Errorf("Unmarshal(%s) failed: %v", tt.input, err)
+			continue
+		}
+		if got.Priority != tt.expected {
+			t.Errorf("Unmarshal(%s) should produce %v, got %v", tt.input, tt.expected, got.Priority)
+		}
+	}
+}
+
+func TestPriorityUnmarshalJSONInvalid(t *testing.T) {
+	invalid := []string{
+		`{"priority":2}`,
+		`{"priority":"Urgent"}`,
+		`{"priority":true}`,
+		`{"priority":-1}`,
+	}
+
+	for _, input := range invalid {
+		var got task
+		if err := json.Unmarshal([]byte(input), &got); err == nil {
+			t.Errorf("Unmarshal(%s) should fail, got %v", input, got.Priority)
+		}
+	}
+}
+
+func TestPriorityJSONRoundTrip(t *testing.T) {
+	for _, p := range PriorityValues() {
+		data, err := json.Marshal(p)
+		if err != nil {
+			t.Fatalf("Marshal(%v) failed: %v", p, err)
+		}
+
+		var got Priority
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("Unmarshal(%s) failed: %v", data, err)
+		}
+		if got != p {
+			t.Errorf("round trip of %v produced %v", p, got)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package testpkg
+
+// Status may receive values added by newer upstream services
+type Status int
+
+const (
+	Pending Status = iota
+	Active
+	Closed
+)
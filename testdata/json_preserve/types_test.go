@@ -0,0 +1,58 @@
+package testpkg
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+type message struct {
+	Status Status `json:"status"`
+}
+
+func TestStatusPreserveKnown(t *testing.T) {
+	data, err := json.Marshal(message{Status: Active})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != `{"status":"Active"}` {
+		t.Errorf("Unexpected JSON %s", data)
+	}
+
+	var m message
+	for _, input := range []string{`{"status":"Closed"}`, `{"status":2}`} {
+		if err := json.Unmarshal([]byte(input), &m); err != nil {
+			t.Fatalf("Unmarshal(%s) failed: %v", input, err)
+		}
+		if m.Status != Closed {
+			t.Errorf("Unmarshal(%s) should produce Closed, got %v", input, m.Status)
+		}
+	}
+}
+
+func TestStatusPreserveUnknownRoundTrip(t *testing.T) {
+	var m message
+	if err := json.Unmarshal([]byte(`{"status":42}`), &m); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if m.Status != Status(42) || m.Status.Valid() {
+		t.Errorf("Expected the unknown value 42, got %v", m.Status)
+	}
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != `{"status":42}` {
+		t.Errorf("Expected the number to be preserved, got %s", data)
+	}
+}
+
+func TestStatusPreserveInvalid(t *testing.T) {
+	var m message
+	for _, input := range []string{`{"status":"Bogus"}`, `{"status":true}`} {
+		if err := json.Unmarshal([]byte(input), &m); !errors.Is(err, ErrInvalidStatus) {
+			t.Errorf("Unmarshal(%s) should fail with ErrInvalidStatus, got %v", input, err)
+		}
+	}
+}
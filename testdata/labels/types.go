@@ -0,0 +1,21 @@
+package testpkg
+
+// Direction has multi-word names, which are labeled after trimming
+type Direction int
+
+const (
+	DirectionNorth Direction = iota
+	DirectionNorthWest
+	DirectionHTTPProxy
+	DirectionUp_Down
+	DirectionDefault = DirectionNorth
+)
+
+// RunStatus names are labeled in full
+type RunStatus int
+
+const (
+	RunPending RunStatus = iota
+	Success
+	RunFailed
+)
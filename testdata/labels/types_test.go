@@ -0,0 +1,46 @@
+package testpkg
+
+import "testing"
+
+func TestDirectionLabel(t *testing.T) {
+	tests := []struct {
+		value    Direction
+		expected string
+	}{
+		{DirectionNorth, "North"},
+		{DirectionNorthWest, "North West"},
+		{DirectionHTTPProxy, "HTTP Proxy"},
+		{DirectionUp_Down, "Up Down"},
+		{DirectionDefault, "North"},
+		{Direction(42), "Direction(42)"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.value.Label(); got != tt.expected {
+			t.Errorf("%d.Label() = %q, expected %q", tt.value, got, tt.expected)
+		}
+	}
+}
+
+func TestLabelIgnoresTransform(t *testing.T) {
+	if s := DirectionNorthWest.String(); s != "north_west" {
+		t.Errorf("Expected north_west, got %q", s)
+	}
+}
+
+func TestRunStatusLabel(t *testing.T) {
+	tests := []struct {
+		value    RunStatus
+		expected string
+	}{
+		{RunPending, "Run Pending"},
+		{Success, "Success"},
+		{RunFailed, "Run Failed"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.value.Label(); got != tt.expected {
+			t.Errorf("%d.Label() = %q, expected %q", tt.value, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+package testpkg
+
+// Color represents an enum whose line comments and names both parse
+type Color int
+
+const (
+	Red    Color = iota // red, crimson
+	Green               // green
+	Blue                // blue
+	Yellow              // Yellow
+)
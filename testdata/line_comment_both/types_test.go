@@ -0,0 +1,39 @@
+package testpkg
+
+import "testing"
+
+func TestColorBothNames(t *testing.T) {
+	tests := []struct {
+		s    string
+		want Color
+	}{
+		{"blue", Blue},
+		{"Blue", Blue},
+		{"red", Red},
+		{"crimson", Red},
+		{"Red", Red},
+		{"Yellow", Yellow},
+	}
+	for _, tt := range tests {
+		got, err := ColorString(tt.s)
+		if err != nil {
+			t.Errorf("ColorString(%q) failed: %v", tt.s, err)
+		} else if got != tt.want {
+			t.Errorf("ColorString(%q) should be %v, got %v", tt.s, tt.want, got)
+		}
+	}
+}
+
+func TestColorBothString(t *testing.T) {
+	// The comment text is still the string representation
+	if got := Blue.String(); got != "blue" {
+		t.Errorf("Blue.String() should be \"blue\", got %q", got)
+	}
+	names := ColorNames()
+	if len(names) != 4 || names[0] != "red" || names[3] != "Yellow" {
+		t.Errorf("ColorNames() should be the comment text, got %v", names)
+	}
+	if n := len(ColorValuesMap()); n != 8 {
+		t.Errorf("ColorValuesMap() should have 8 strings, got %d", n)
+	}
+}
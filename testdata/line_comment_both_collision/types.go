@@ -0,0 +1,10 @@
+package testpkg
+
+// Color represents an enum where a line comment matches another
+// constant's name
+type Color int
+
+const (
+	Red     Color = iota // red
+	Crimson              // Red
+)
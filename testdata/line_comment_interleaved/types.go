@@ -0,0 +1,26 @@
+package testpkg
+
+// Color declares constants whose specs span several lines, with a line
+// comment naming the constants on each
+type Color int
+
+const (
+	Red   Color = iota // red
+	Green              // green
+
+	// Names on their own lines take the comment ending the line
+	Blue, // blue
+	Cyan Color = 10, 11 // cyan
+
+	// Values on their own lines do too
+	Magenta, Yellow Color = 20, // magenta
+		21 // yellow
+
+	// A line with several names gives one for each
+	Black, White, // black, white
+	Grey Color = 30, 31, 32 // grey
+
+	// A comment on the last line only names every constant in turn
+	Orange,
+	Purple Color = 40, 41 // orange, purple
+)
@@ -0,0 +1,31 @@
+package testpkg
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestColorInterleavedComments(t *testing.T) {
+	expected := []string{"red", "green", "blue", "cyan", "magenta", "yellow", "black", "white", "grey", "orange", "purple"}
+	if names := ColorNames(); !reflect.DeepEqual(names, expected) {
+		t.Errorf("Expected %v, got %v", expected, names)
+	}
+}
+
+func TestColorInterleavedParse(t *testing.T) {
+	tests := map[string]Color{
+		"blue":    Blue,
+		"cyan":    Cyan,
+		"magenta": Magenta,
+		"yellow":  Yellow,
+		"white":   White,
+		"grey":    Grey,
+		"purple":  Purple,
+	}
+
+	for s, expected := range tests {
+		if got, err := ColorString(s); err != nil || got != expected {
+			t.Errorf("ColorString(%q) should be %d, got %d, %v", s, expected, got, err)
+		}
+	}
+}
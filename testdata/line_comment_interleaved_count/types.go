@@ -0,0 +1,9 @@
+package testpkg
+
+// Color names only one of the two constants on a line
+type Color int
+
+const (
+	Black, White, // black
+	Grey Color = 1, 2, 3 // grey
+)
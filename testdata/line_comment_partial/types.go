@@ -0,0 +1,12 @@
+package testpkg
+
+// Color has line comments on only some of its constants
+type Color int
+
+const (
+	Red Color = iota // red
+	Green
+	Blue // blue
+	Yellow
+	Crimson = Red
+)
@@ -0,0 +1,20 @@
+package testpkg
+
+// Shape and Size are declared in the same const block
+type Shape int
+
+// Size is retyped part way through the block
+type Size int
+
+const (
+	Circle Shape = iota // circle
+	Square              // square
+	Small  Size  = iota // small
+	Large               // large
+
+	// Several constants can be declared by one spec, with a name for each
+	Triangle, Hexagon Shape = 10, 11 // triangle, hexagon
+	Medium, Huge      Size  = 5, 20  // medium, huge
+	_, Oval           Shape = 12, 13 // unused, oval
+	Tiny, Giant       Size  = 1, 30
+)
@@ -0,0 +1,45 @@
+package testpkg
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestShapeConstants(t *testing.T) {
+	expected := []Shape{Circle, Square, Triangle, Hexagon, Oval}
+	if values := ShapeValues(); !reflect.DeepEqual(values, expected) {
+		t.Errorf("Expected %v, got %v", expected, values)
+	}
+	expectedNames := []string{"circle", "square", "triangle", "hexagon", "oval"}
+	if names := ShapeNames(); !reflect.DeepEqual(names, expectedNames) {
+		t.Errorf("Expected %v, got %v", expectedNames, names)
+	}
+}
+
+func TestSizeConstants(t *testing.T) {
+	// Values are sorted, so Tiny is placed first. Small continues the
+	// block's iota, so it's 2
+	expected := []Size{Tiny, Small, Large, Medium, Huge, Giant}
+	if values := SizeValues(); !reflect.DeepEqual(values, expected) {
+		t.Errorf("Expected %v, got %v", expected, values)
+	}
+	expectedNames := []string{"Tiny", "small", "large", "medium", "huge", "Giant"}
+	if names := SizeNames(); !reflect.DeepEqual(names, expectedNames) {
+		t.Errorf("Expected %v, got %v", expectedNames, names)
+	}
+	if Small != 2 || Huge != 20 {
+		t.Errorf("Unexpected values Small=%d Huge=%d", Small, Huge)
+	}
+}
+
+func TestMixedBlockParse(t *testing.T) {
+	if v, err := ShapeString("hexagon"); err != nil || v != Hexagon {
+		t.Errorf("Expected Hexagon, got %v, %v", v, err)
+	}
+	if _, err := ShapeString("medium"); err == nil {
+		t.Error("Expected a Size name not to parse as a Shape")
+	}
+	if _, err := ShapeString("unused"); err == nil {
+		t.Error("Expected the blank constant's name not to parse")
+	}
+}
@@ -0,0 +1,8 @@
+package testpkg
+
+// Color declares two constants with a single name in their comment
+type Color int
+
+const (
+	Red, Green Color = 1, 2 // red
+)
@@ -0,0 +1,13 @@
+package testpkg
+
+// Status has constants declared under two historical prefixes
+type Status int
+
+const (
+	StatusPending Status = iota
+	StatusRunning
+	StateDone
+	StateFailed
+	StatusLegacyArchived
+	Unknown
+)
@@ -0,0 +1,53 @@
+package testpkg
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStatusString(t *testing.T) {
+	tests := []struct {
+		value    Status
+		expected string
+	}{
+		{StatusPending, "Pending"},
+		{StatusRunning, "Running"},
+		{StateDone, "Done"},
+		{StateFailed, "Failed"},
+		{StatusLegacyArchived, "Archived"}, // the longer prefix wins
+		{Unknown, "Unknown"},               // no prefix matches
+	}
+
+	for _, tt := range tests {
+		if got := tt.value.String(); got != tt.expected {
+			t.Errorf("%d.String() should be %s, got %s", tt.value, tt.expected, got)
+		}
+	}
+}
+
+func TestStatusParse(t *testing.T) {
+	for _, s := range StatusValues() {
+		got, err := StatusString(s.String())
+		if err != nil {
+			t.Errorf("StatusString(%s) failed: %v", s, err)
+			continue
+		}
+		if got != s {
+			t.Errorf("StatusString(%s) should produce %d, got %d", s, s, got)
+		}
+	}
+
+	if _, err := StatusString("StateDone"); err == nil {
+		t.Error("StatusString(StateDone) should fail, as the prefix is trimmed")
+	}
+}
+
+func TestStatusJSON(t *testing.T) {
+	data, err := json.Marshal(StateFailed)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != `"Failed"` {
+		t.Errorf("Marshal should produce \"Failed\", got %s", data)
+	}
+}
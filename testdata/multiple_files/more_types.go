@@ -0,0 +1,6 @@
+package testpkg
+
+const (
+	Test    Stage = 1
+	Monitor Stage = 3
+)
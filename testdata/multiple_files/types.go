@@ -0,0 +1,9 @@
+package testpkg
+
+// Stage represents an enum whose constants are spread across files
+type Stage int
+
+const (
+	Build  Stage = 0
+	Deploy Stage = 2
+)
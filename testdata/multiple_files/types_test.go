@@ -0,0 +1,30 @@
+package testpkg
+
+import "testing"
+
+func TestStageValuesOrder(t *testing.T) {
+	expected := []Stage{Build, Test, Deploy, Monitor}
+
+	values := StageValues()
+	if len(values) != len(expected) {
+		t.Fatalf("Expected %d values, got %d", len(expected), len(values))
+	}
+	for i := range expected {
+		if values[i] != expected[i] {
+			t.Errorf("Value %d: expected %v, got %v", i, expected[i], values[i])
+		}
+	}
+}
+
+func TestStageParseAcrossFiles(t *testing.T) {
+	for _, name := range []string{"Build", "Test", "Deploy", "Monitor"} {
+		s, err := StageString(name)
+		if err != nil {
+			t.Errorf("Failed to parse %q: %v", name, err)
+			continue
+		}
+		if s.String() != name {
+			t.Errorf("Expected %q, got %q", name, s.String())
+		}
+	}
+}
@@ -0,0 +1,11 @@
+package testpkg
+
+// Result uses -1 as a sentinel for an unknown result
+type Result int
+
+const (
+	Unknown Result = iota - 1
+	Passed
+	Failed
+	Skipped
+)
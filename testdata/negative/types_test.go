@@ -0,0 +1,85 @@
+package testpkg
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestResultString(t *testing.T) {
+	tests := []struct {
+		value    Result
+		expected string
+	}{
+		{Unknown, "Unknown"},
+		{Passed, "Passed"},
+		{Skipped, "Skipped"},
+		{Result(-2), "Result(-2)"},
+		{Result(3), "Result(3)"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.value.String(); got != tt.expected {
+			t.Errorf("%d.String() should be %s, got %s", int(tt.value), tt.expected, got)
+		}
+	}
+}
+
+func TestResultValid(t *testing.T) {
+	if !Unknown.Valid() {
+		t.Error("Unknown should be valid")
+	}
+	if Result(-2).Valid() {
+		t.Error("Result(-2) should not be valid")
+	}
+}
+
+func TestResultParse(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Result
+	}{
+		{"Unknown", Unknown},
+		{"-1", Unknown},
+		{"1", Failed},
+	}
+
+	for _, tt := range tests {
+		got, err := ResultString(tt.input)
+		if err != nil || got != tt.expected {
+			t.Errorf("ResultString(%q) should produce %v, got %v, %v", tt.input, tt.expected, got, err)
+		}
+	}
+
+	if _, err := ResultString("-2"); err == nil {
+		t.Error("ResultString(-2) should fail")
+	}
+}
+
+func TestResultOrdering(t *testing.T) {
+	values := ResultValues()
+	expected := []Result{Unknown, Passed, Failed, Skipped}
+	if len(values) != len(expected) {
+		t.Fatalf("ResultValues() should be %v, got %v", expected, values)
+	}
+	for i := range expected {
+		if values[i] != expected[i] {
+			t.Errorf("ResultValues()[%d] should be %v, got %v", i, expected[i], values[i])
+		}
+	}
+
+	if ResultMin() != Unknown || ResultMax() != Skipped {
+		t.Errorf("bounds should be Unknown and Skipped, got %v and %v", ResultMin(), ResultMax())
+	}
+}
+
+func TestResultJSON(t *testing.T) {
+	data, err := json.Marshal(Unknown)
+	if err != nil || string(data) != "-1" {
+		t.Errorf("Marshal(Unknown) should produce -1, got %s, %v", data, err)
+	}
+
+	var r Result
+	if err := json.Unmarshal([]byte("-1"), &r); err != nil || r != Unknown {
+		t.Errorf("Unmarshal(-1) should produce Unknown, got %v, %v", r, err)
+	}
+}
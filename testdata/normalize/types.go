@@ -0,0 +1,10 @@
+package testpkg
+
+// Status is read from user input, which may be spelled loosely
+type Status int
+
+const (
+	Pending Status = iota
+	Success
+	Failure
+)
@@ -0,0 +1,21 @@
+package testpkg
+
+import "testing"
+
+func TestNormalizeStatus(t *testing.T) {
+	for _, s := range []string{"Success", "success", " success ", "SUCCESS\t"} {
+		if got, err := NormalizeStatus(s); err != nil || got != "Success" {
+			t.Errorf("NormalizeStatus(%q) should be \"Success\", got %q, %v", s, got, err)
+		}
+	}
+}
+
+func TestNormalizeStatusInvalid(t *testing.T) {
+	for _, s := range []string{"", "junk", "succes", "Success,Failure"} {
+		if got, err := NormalizeStatus(s); err == nil {
+			t.Errorf("NormalizeStatus(%q) should fail, got %q", s, got)
+		} else if got != "" {
+			t.Errorf("NormalizeStatus(%q) should return an empty string on failure, got %q", s, got)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package testpkg
+
+// Status is stored in a nullable column
+type Status int
+
+const (
+	Pending Status = iota
+	Active
+	Closed
+)
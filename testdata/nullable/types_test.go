@@ -0,0 +1,77 @@
+package testpkg
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+)
+
+var (
+	_ sql.Scanner   = (*NullStatus)(nil)
+	_ driver.Valuer = NullStatus{}
+)
+
+func TestNullStatusScanNull(t *testing.T) {
+	n := NullStatus{Status: Closed, Valid: true}
+	if err := n.Scan(nil); err != nil {
+		t.Fatalf("Scan(nil) failed: %v", err)
+	}
+	if n.Valid {
+		t.Error("Scan(nil) should set Valid to false")
+	}
+	if n.Status != Pending {
+		t.Errorf("Scan(nil) should reset Status to the zero value, got %v", n.Status)
+	}
+}
+
+func TestNullStatusScanValue(t *testing.T) {
+	var n NullStatus
+	if err := n.Scan([]byte("Active")); err != nil {
+		t.Fatalf("Scan(Active) failed: %v", err)
+	}
+	if !n.Valid {
+		t.Error("Scan(Active) should set Valid to true")
+	}
+	if n.Status != Active {
+		t.Errorf("Scan(Active) should produce Active, got %v", n.Status)
+	}
+}
+
+func TestNullStatusScanInvalid(t *testing.T) {
+	n := NullStatus{Status: Active, Valid: true}
+	if err := n.Scan("Unknown"); err == nil {
+		t.Error("Scan(Unknown) should fail")
+	}
+	if n.Valid {
+		t.Error("a failed Scan should set Valid to false")
+	}
+}
+
+func TestNullStatusValue(t *testing.T) {
+	v, err := NullStatus{}.Value()
+	if err != nil {
+		t.Fatalf("Value failed: %v", err)
+	}
+	if v != nil {
+		t.Errorf("Value of an invalid NullStatus should be nil, got %#v", v)
+	}
+
+	v, err = NullStatus{Status: Closed, Valid: true}.Value()
+	if err != nil {
+		t.Fatalf("Value failed: %v", err)
+	}
+	if v != "Closed" {
+		t.Errorf("Value should be Closed, got %#v", v)
+	}
+}
+
+func TestStatusScanUnchanged(t *testing.T) {
+	// The base type keeps its existing behavior
+	s := Active
+	if err := s.Scan(nil); err != nil {
+		t.Errorf("Scan(nil) failed: %v", err)
+	}
+	if s != Active {
+		t.Errorf("Scan(nil) should leave the value unchanged, got %v", s)
+	}
+}
@@ -0,0 +1,20 @@
+package testpkg
+
+// Status represents a signed enum that can be parsed from its numeric value
+type Status int
+
+const (
+	Pending Status = iota
+	Running
+	Success
+	Failure
+)
+
+// Level represents an unsigned enum that can be parsed from its numeric value
+type Level uint8
+
+const (
+	Low    Level = 1
+	Medium Level = 5
+	High   Level = 10
+)
@@ -0,0 +1,60 @@
+package testpkg
+
+import "testing"
+
+func TestStatusParseNumber(t *testing.T) {
+	s, err := StatusString("2")
+	if err != nil {
+		t.Fatalf("Failed to parse '2': %v", err)
+	}
+	if s != Success {
+		t.Errorf("Expected Success, got %v", s)
+	}
+
+	// Names still parse as before
+	s, err = StatusString("Failure")
+	if err != nil {
+		t.Fatalf("Failed to parse 'Failure': %v", err)
+	}
+	if s != Failure {
+		t.Errorf("Expected Failure, got %v", s)
+	}
+}
+
+func TestStatusParseNumberInvalid(t *testing.T) {
+	// Numbers that aren't named constants are rejected
+	for _, input := range []string{"4", "-1", "99", "1.5", ""} {
+		if _, err := StatusString(input); err == nil {
+			t.Errorf("Expected error parsing %q", input)
+		}
+	}
+}
+
+func TestStatusParseNumberRoundTrip(t *testing.T) {
+	for _, v := range StatusValues() {
+		parsed, err := StatusString(v.String())
+		if err != nil || parsed != v {
+			t.Errorf("Round trip of %v failed: got %v, %v", v, parsed, err)
+		}
+	}
+}
+
+func TestLevelParseNumber(t *testing.T) {
+	l, err := LevelString("5")
+	if err != nil {
+		t.Fatalf("Failed to parse '5': %v", err)
+	}
+	if l != Medium {
+		t.Errorf("Expected Medium, got %v", l)
+	}
+
+	// Negative numbers are never valid for an unsigned enum
+	if _, err := LevelString("-1"); err == nil {
+		t.Error("Expected error parsing '-1'")
+	}
+
+	// 261 would truncate to 5 as a uint8, so must not be accepted as Medium
+	if _, err := LevelString("261"); err == nil {
+		t.Error("Expected error parsing '261'")
+	}
+}
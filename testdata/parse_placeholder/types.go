@@ -0,0 +1,18 @@
+package testpkg
+
+// Status represents an enum whose unnamed values are read back from logs
+type Status int
+
+const (
+	Pending Status = iota
+	Running
+	Done
+)
+
+// Level is unsigned, so its placeholders can't be negative
+type Level uint8
+
+const (
+	Low Level = iota + 1
+	High
+)
@@ -0,0 +1,57 @@
+package testpkg
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStatusPlaceholderRoundTrip(t *testing.T) {
+	for _, s := range []Status{Running, Status(99), Status(-3)} {
+		got, err := StatusString(s.String())
+		if err != nil {
+			t.Errorf("StatusString(%q) failed: %v", s.String(), err)
+		} else if got != s {
+			t.Errorf("StatusString(%q) should be %d, got %d", s.String(), s, got)
+		}
+	}
+
+	if got, err := StatusString("Status(1)"); err != nil || got != Running {
+		t.Errorf("StatusString(\"Status(1)\") should be Running, got %v, %v", got, err)
+	}
+	if !IsValidStatusName("Status(99)") {
+		t.Error("IsValidStatusName should accept the placeholder")
+	}
+}
+
+func TestStatusPlaceholderInvalid(t *testing.T) {
+	for _, s := range []string{"Status()", "Status(x)", "Status(1", "Level(1)", "status(1)", "99"} {
+		if _, err := StatusString(s); err == nil {
+			t.Errorf("StatusString(%q) should fail", s)
+		}
+	}
+}
+
+func TestLevelPlaceholder(t *testing.T) {
+	if got, err := LevelString("Level(0)"); err != nil || got != Level(0) {
+		t.Errorf("LevelString(\"Level(0)\") should be Level(0), got %v, %v", got, err)
+	}
+	for _, s := range []string{"Level(-1)", "Level(256)"} {
+		if _, err := LevelString(s); err == nil {
+			t.Errorf("LevelString(%q) should fail", s)
+		}
+	}
+}
+
+func TestStatusPlaceholderJSON(t *testing.T) {
+	data, err := json.Marshal(Status(42))
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	var s Status
+	if err := json.Unmarshal(data, &s); err != nil {
+		t.Fatalf("Unmarshal of %s failed: %v", data, err)
+	}
+	if s != Status(42) {
+		t.Errorf("Expected Status(42), got %d", s)
+	}
+}
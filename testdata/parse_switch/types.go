@@ -0,0 +1,511 @@
+package testpkg
+
+// Code is a large, sparse enum, looked up with switch statements rather
+// than maps
+type Code int
+
+const (
+	Code000 Code = iota * 2
+	Code001
+	Code002
+	Code003
+	Code004
+	Code005
+	Code006
+	Code007
+	Code008
+	Code009
+	Code010
+	Code011
+	Code012
+	Code013
+	Code014
+	Code015
+	Code016
+	Code017
+	Code018
+	Code019
+	Code020
+	Code021
+	Code022
+	Code023
+	Code024
+	Code025
+	Code026
+	Code027
+	Code028
+	Code029
+	Code030
+	Code031
+	Code032
+	Code033
+	Code034
+	Code035
+	Code036
+	Code037
+	Code038
+	Code039
+	Code040
+	Code041
+	Code042
+	Code043
+	Code044
+	Code045
+	Code046
+	Code047
+	Code048
+	Code049
+	Code050
+	Code051
+	Code052
+	Code053
+	Code054
+	Code055
+	Code056
+	Code057
+	Code058
+	Code059
+	Code060
+	Code061
+	Code062
+	Code063
+	Code064
+	Code065
+	Code066
+	Code067
+	Code068
+	Code069
+	Code070
+	Code071
+	Code072
+	Code073
+	Code074
+	Code075
+	Code076
+	Code077
+	Code078
+	Code079
+	Code080
+	Code081
+	Code082
+	Code083
+	Code084
+	Code085
+	Code086
+	Code087
+	Code088
+	Code089
+	Code090
+	Code091
+	Code092
+	Code093
+	Code094
+	Code095
+	Code096
+	Code097
+	Code098
+	Code099
+	Code100
+	Code101
+	Code102
+	Code103
+	Code104
+	Code105
+	Code106
+	Code107
+	Code108
+	Code109
+	Code110
+	Code111
+	Code112
+	Code113
+	Code114
+	Code115
+	Code116
+	Code117
+	Code118
+	Code119
+	Code120
+	Code121
+	Code122
+	Code123
+	Code124
+	Code125
+	Code126
+	Code127
+	Code128
+	Code129
+	Code130
+	Code131
+	Code132
+	Code133
+	Code134
+	Code135
+	Code136
+	Code137
+	Code138
+	Code139
+	Code140
+	Code141
+	Code142
+	Code143
+	Code144
+	Code145
+	Code146
+	Code147
+	Code148
+	Code149
+	Code150
+	Code151
+	Code152
+	Code153
+	Code154
+	Code155
+	Code156
+	Code157
+	Code158
+	Code159
+	Code160
+	Code161
+	Code162
+	Code163
+	Code164
+	Code165
+	Code166
+	Code167
+	Code168
+	Code169
+	Code170
+	Code171
+	Code172
+	Code173
+	Code174
+	Code175
+	Code176
+	Code177
+	Code178
+	Code179
+	Code180
+	Code181
+	Code182
+	Code183
+	Code184
+	Code185
+	Code186
+	Code187
+	Code188
+	Code189
+	Code190
+	Code191
+	Code192
+	Code193
+	Code194
+	Code195
+	Code196
+	Code197
+	Code198
+	Code199
+	Code200
+	Code201
+	Code202
+	Code203
+	Code204
+	Code205
+	Code206
+	Code207
+	Code208
+	Code209
+	Code210
+	Code211
+	Code212
+	Code213
+	Code214
+	Code215
+	Code216
+	Code217
+	Code218
+	Code219
+	Code220
+	Code221
+	Code222
+	Code223
+	Code224
+	Code225
+	Code226
+	Code227
+	Code228
+	Code229
+	Code230
+	Code231
+	Code232
+	Code233
+	Code234
+	Code235
+	Code236
+	Code237
+	Code238
+	Code239
+	Code240
+	Code241
+	Code242
+	Code243
+	Code244
+	Code245
+	Code246
+	Code247
+	Code248
+	Code249
+	Code250
+	Code251
+	Code252
+	Code253
+	Code254
+	Code255
+	Code256
+	Code257
+	Code258
+	Code259
+	Code260
+	Code261
+	Code262
+	Code263
+	Code264
+	Code265
+	Code266
+	Code267
+	Code268
+	Code269
+	Code270
+	Code271
+	Code272
+	Code273
+	Code274
+	Code275
+	Code276
+	Code277
+	Code278
+	Code279
+	Code280
+	Code281
+	Code282
+	Code283
+	Code284
+	Code285
+	Code286
+	Code287
+	Code288
+	Code289
+	Code290
+	Code291
+	Code292
+	Code293
+	Code294
+	Code295
+	Code296
+	Code297
+	Code298
+	Code299
+	Code300
+	Code301
+	Code302
+	Code303
+	Code304
+	Code305
+	Code306
+	Code307
+	Code308
+	Code309
+	Code310
+	Code311
+	Code312
+	Code313
+	Code314
+	Code315
+	Code316
+	Code317
+	Code318
+	Code319
+	Code320
+	Code321
+	Code322
+	Code323
+	Code324
+	Code325
+	Code326
+	Code327
+	Code328
+	Code329
+	Code330
+	Code331
+	Code332
+	Code333
+	Code334
+	Code335
+	Code336
+	Code337
+	Code338
+	Code339
+	Code340
+	Code341
+	Code342
+	Code343
+	Code344
+	Code345
+	Code346
+	Code347
+	Code348
+	Code349
+	Code350
+	Code351
+	Code352
+	Code353
+	Code354
+	Code355
+	Code356
+	Code357
+	Code358
+	Code359
+	Code360
+	Code361
+	Code362
+	Code363
+	Code364
+	Code365
+	Code366
+	Code367
+	Code368
+	Code369
+	Code370
+	Code371
+	Code372
+	Code373
+	Code374
+	Code375
+	Code376
+	Code377
+	Code378
+	Code379
+	Code380
+	Code381
+	Code382
+	Code383
+	Code384
+	Code385
+	Code386
+	Code387
+	Code388
+	Code389
+	Code390
+	Code391
+	Code392
+	Code393
+	Code394
+	Code395
+	Code396
+	Code397
+	Code398
+	Code399
+	Code400
+	Code401
+	Code402
+	Code403
+	Code404
+	Code405
+	Code406
+	Code407
+	Code408
+	Code409
+	Code410
+	Code411
+	Code412
+	Code413
+	Code414
+	Code415
+	Code416
+	Code417
+	Code418
+	Code419
+	Code420
+	Code421
+	Code422
+	Code423
+	Code424
+	Code425
+	Code426
+	Code427
+	Code428
+	Code429
+	Code430
+	Code431
+	Code432
+	Code433
+	Code434
+	Code435
+	Code436
+	Code437
+	Code438
+	Code439
+	Code440
+	Code441
+	Code442
+	Code443
+	Code444
+	Code445
+	Code446
+	Code447
+	Code448
+	Code449
+	Code450
+	Code451
+	Code452
+	Code453
+	Code454
+	Code455
+	Code456
+	Code457
+	Code458
+	Code459
+	Code460
+	Code461
+	Code462
+	Code463
+	Code464
+	Code465
+	Code466
+	Code467
+	Code468
+	Code469
+	Code470
+	Code471
+	Code472
+	Code473
+	Code474
+	Code475
+	Code476
+	Code477
+	Code478
+	Code479
+	Code480
+	Code481
+	Code482
+	Code483
+	Code484
+	Code485
+	Code486
+	Code487
+	Code488
+	Code489
+	Code490
+	Code491
+	Code492
+	Code493
+	Code494
+	Code495
+	Code496
+	Code497
+	Code498
+	Code499
+
+	// CodeDefault is an alias of Code000
+	CodeDefault = Code000
+)
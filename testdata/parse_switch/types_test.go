@@ -0,0 +1,131 @@
+package testpkg
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+func TestCodeString(t *testing.T) {
+	if got := Code123.String(); got != "Code123" {
+		t.Errorf("Code123.String() should be \"Code123\", got %q", got)
+	}
+	if got := Code(1).String(); got != "Code(1)" {
+		t.Errorf("Code(1).String() should be \"Code(1)\", got %q", got)
+	}
+	if got := CodeDefault.String(); got != "Code000" {
+		t.Errorf("CodeDefault.String() should be \"Code000\", got %q", got)
+	}
+}
+
+func TestCodeParse(t *testing.T) {
+	tests := []struct {
+		s    string
+		want Code
+	}{
+		{"Code499", Code499},
+		{"CodeDefault", Code000},
+		{"code042", Code042},
+		{"246", Code123},
+	}
+	for _, tt := range tests {
+		got, err := CodeString(tt.s)
+		if err != nil {
+			t.Errorf("CodeString(%q) failed: %v", tt.s, err)
+		} else if got != tt.want {
+			t.Errorf("CodeString(%q) should be %v, got %v", tt.s, tt.want, got)
+		}
+	}
+
+	for _, s := range []string{"Code500", "1", ""} {
+		if _, err := CodeString(s); err == nil {
+			t.Errorf("CodeString(%q) should fail", s)
+		}
+		if IsValidCodeName(s) {
+			t.Errorf("IsValidCodeName(%q) should be false", s)
+		}
+	}
+}
+
+func TestCodeValid(t *testing.T) {
+	if !Code499.Valid() || !Code(998).Valid() {
+		t.Error("Code499 should be valid")
+	}
+	if Code(999).Valid() || Code(1000).Valid() {
+		t.Error("values between and beyond the constants should not be valid")
+	}
+	if n := len(CodeValues()); n != 500 {
+		t.Errorf("CodeValues() should have 500 values, got %d", n)
+	}
+}
+
+func TestCodeValuesMap(t *testing.T) {
+	m := CodeValuesMap()
+	if len(m) != 501 || m["CodeDefault"] != Code000 || m["Code007"] != Code007 {
+		t.Errorf("CodeValuesMap() should map all 501 names, got %d", len(m))
+	}
+	delete(m, "Code007")
+	if _, ok := CodeValuesMap()["Code007"]; !ok {
+		t.Error("CodeValuesMap() should return a new map each time")
+	}
+}
+
+func TestCodeJSON(t *testing.T) {
+	data, err := json.Marshal(Code321)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	var c Code
+	if err := json.Unmarshal(data, &c); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if c != Code321 {
+		t.Errorf("Expected Code321, got %v", c)
+	}
+}
+
+// TestCodeNoMaps checks nothing is built at init, which is the point of
+// -parsemode=switch
+func TestCodeNoMaps(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "code_enumer.go", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vspec := spec.(*ast.ValueSpec)
+			for _, value := range vspec.Values {
+				if lit, ok := value.(*ast.CompositeLit); ok {
+					if _, ok := lit.Type.(*ast.MapType); ok {
+						t.Errorf("%s is a map", vspec.Names[0].Name)
+					}
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkCodeString(b *testing.B) {
+	names := CodeNames()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := CodeString(names[i%len(names)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkCodeValuesMap builds the map of every name, which is what the
+// map parse mode allocates at init
+func BenchmarkCodeValuesMap(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = CodeValuesMap()
+	}
+}
@@ -0,0 +1,22 @@
+package testpkg
+
+import "unsafe"
+
+// Size represents an enum with a value measured on the platform
+type Size int
+
+const (
+	Byte Size = 1
+	Word Size = Size(unsafe.Sizeof(uintptr(0)))
+)
+
+// uintBits is the size of a uint, which depends on the platform
+const uintBits = 32 << (^uint(0) >> 63)
+
+// Width represents an enum using a platform dependent constant
+type Width int
+
+const (
+	Narrow Width = 8
+	Native Width = uintBits
+)
@@ -0,0 +1,26 @@
+package testpkg
+
+// Foo mirrors an enum generated by protoc-gen-go, with explicit values
+// declared out of order between unrelated constants
+type Foo int32
+
+const (
+	Foo_ACTIVE   Foo = 2
+	maxRetries       = 3
+	Foo_UNKNOWN  Foo = 0
+	defaultName      = "foo"
+	Foo_INACTIVE Foo = 1
+	Foo_DELETED  Foo = 3
+)
+
+// Bar is sparse, with its smallest value declared last in another block
+type Bar int32
+
+const (
+	Bar_HIGH Bar = 10
+	Bar_LOW  Bar = 1
+)
+
+const Bar_NONE Bar = -1
+
+const Foo_PAUSED Foo = 4
@@ -0,0 +1,91 @@
+package testpkg
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestFooOrder(t *testing.T) {
+	want := []Foo{Foo_UNKNOWN, Foo_INACTIVE, Foo_ACTIVE, Foo_DELETED, Foo_PAUSED}
+	if got := FooValues(); !reflect.DeepEqual(got, want) {
+		t.Errorf("FooValues() should be %v, got %v", want, got)
+	}
+	if got, want := FooNames(), []string{"UNKNOWN", "INACTIVE", "ACTIVE", "DELETED", "PAUSED"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FooNames() should be %v, got %v", want, got)
+	}
+	if FooMin() != Foo_UNKNOWN || FooMax() != Foo_PAUSED {
+		t.Errorf("FooMin() and FooMax() should be UNKNOWN and PAUSED, got %v and %v", FooMin(), FooMax())
+	}
+	if next, ok := Foo_INACTIVE.Next(); !ok || next != Foo_ACTIVE {
+		t.Errorf("Foo_INACTIVE.Next() should be ACTIVE, got %v", next)
+	}
+}
+
+func TestFooString(t *testing.T) {
+	for _, tt := range []struct {
+		foo  Foo
+		want string
+	}{
+		{Foo_UNKNOWN, "UNKNOWN"},
+		{Foo_INACTIVE, "INACTIVE"},
+		{Foo_ACTIVE, "ACTIVE"},
+		{Foo_DELETED, "DELETED"},
+		{Foo_PAUSED, "PAUSED"},
+		{Foo(5), "Foo(5)"},
+		{Foo(-1), "Foo(-1)"},
+	} {
+		if got := tt.foo.String(); got != tt.want {
+			t.Errorf("Foo(%d).String() should be %q, got %q", tt.foo, tt.want, got)
+		}
+		if got := tt.foo.Valid(); got != (tt.foo >= 0 && tt.foo <= 4) {
+			t.Errorf("Foo(%d).Valid() should be %v", tt.foo, !got)
+		}
+	}
+
+	for _, name := range FooNames() {
+		foo, err := FooString(name)
+		if err != nil {
+			t.Errorf("FooString(%q) failed: %v", name, err)
+		} else if foo.String() != name {
+			t.Errorf("FooString(%q) should round trip, got %v", name, foo)
+		}
+	}
+	if _, err := FooString("Foo_ACTIVE"); err == nil {
+		t.Error("FooString should not accept the untrimmed name")
+	}
+}
+
+func TestBarOrder(t *testing.T) {
+	want := []Bar{Bar_NONE, Bar_LOW, Bar_HIGH}
+	if got := BarValues(); !reflect.DeepEqual(got, want) {
+		t.Errorf("BarValues() should be %v, got %v", want, got)
+	}
+	if BarMin() != Bar_NONE || BarMax() != Bar_HIGH {
+		t.Errorf("BarMin() and BarMax() should be NONE and HIGH, got %v and %v", BarMin(), BarMax())
+	}
+	if got := Bar_NONE.String(); got != "NONE" {
+		t.Errorf("Bar_NONE.String() should be \"NONE\", got %q", got)
+	}
+	if Bar(2).Valid() {
+		t.Error("Bar(2) should not be valid")
+	}
+}
+
+func TestFooJSON(t *testing.T) {
+	data, err := json.Marshal([]Foo{Foo_ACTIVE, Foo_UNKNOWN})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != `["ACTIVE","UNKNOWN"]` {
+		t.Errorf("Expected [\"ACTIVE\",\"UNKNOWN\"], got %s", data)
+	}
+
+	var bars []Bar
+	if err := json.Unmarshal([]byte(`["HIGH","NONE"]`), &bars); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if !reflect.DeepEqual(bars, []Bar{Bar_HIGH, Bar_NONE}) {
+		t.Errorf("Expected [HIGH NONE], got %v", bars)
+	}
+}
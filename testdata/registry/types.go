@@ -0,0 +1,19 @@
+package testpkg
+
+// Status is looked up through the registry
+type Status int
+
+const (
+	Pending Status = iota
+	Running
+	Success
+	Failure
+)
+
+// Level is registered alongside Status
+type Level uint8
+
+const (
+	Low Level = iota + 1
+	High
+)
@@ -0,0 +1,69 @@
+package testpkg
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/spaceweasel/enumer/enumregistry"
+)
+
+func TestRegistryParse(t *testing.T) {
+	v, err := enumregistry.Parse("test.Status", "Success")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if Status(v) != Success {
+		t.Errorf("Expected Success, got %v", Status(v))
+	}
+
+	if _, err := enumregistry.Parse("test.Status", "Bogus"); !errors.Is(err, ErrInvalidStatus) {
+		t.Errorf("Expected ErrInvalidStatus, got %v", err)
+	}
+	if _, err := enumregistry.Parse("Status", "Success"); !errors.Is(err, enumregistry.ErrUnknownType) {
+		t.Errorf("Expected the unqualified name to be unknown, got %v", err)
+	}
+	if _, err := enumregistry.Parse("test.Missing", "Success"); !errors.Is(err, enumregistry.ErrUnknownType) {
+		t.Errorf("Expected ErrUnknownType, got %v", err)
+	}
+}
+
+func TestRegistryKey(t *testing.T) {
+	// Types are registered by package path and name
+	key := reflect.TypeOf(Status(0)).PkgPath() + ".Status"
+	if key != "test.Status" {
+		t.Fatalf("Unexpected key %q", key)
+	}
+	v, err := enumregistry.Parse(key, "Failure")
+	if err != nil || Status(v) != Failure {
+		t.Errorf("Expected Failure from %q, got %v, %v", key, Status(v), err)
+	}
+	s, err := enumregistry.String(key, int64(Success))
+	if err != nil || s != "Success" {
+		t.Errorf("Expected Success from %q, got %q, %v", key, s, err)
+	}
+}
+
+func TestRegistryString(t *testing.T) {
+	s, err := enumregistry.String("test.Level", 2)
+	if err != nil {
+		t.Fatalf("String failed: %v", err)
+	}
+	if s != "High" {
+		t.Errorf("Expected High, got %q", s)
+	}
+
+	// Values that don't fit the underlying type aren't truncated
+	for _, v := range []int64{0, 258, -1} {
+		if _, err := enumregistry.String("test.Level", v); !errors.Is(err, ErrInvalidLevel) {
+			t.Errorf("Expected ErrInvalidLevel for %d, got %v", v, err)
+		}
+	}
+}
+
+func TestRegistryNames(t *testing.T) {
+	names := enumregistry.Names()
+	if len(names) != 2 || names[0] != "test.Level" || names[1] != "test.Status" {
+		t.Errorf("Expected [test.Level test.Status], got %v", names)
+	}
+}
@@ -0,0 +1,11 @@
+package testpkg
+
+// Status represents an enum with gaps between its values
+type Status int
+
+const (
+	Draft     Status = 1
+	Review    Status = 5
+	Published Status = 10
+	Archived  Status = 20
+)
@@ -0,0 +1,47 @@
+package testpkg
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStatusSet(t *testing.T) {
+	allowed := NewStatusSet(Published, Draft)
+	if !allowed.Contains(Draft) || !allowed.Contains(Published) {
+		t.Errorf("%v should contain Draft and Published", allowed.Slice())
+	}
+	if allowed.Contains(Review) {
+		t.Errorf("%v should not contain Review", allowed.Slice())
+	}
+
+	allowed.Add(Archived, Review, Archived)
+	allowed.Remove(Draft, Draft)
+	if allowed.Contains(Draft) {
+		t.Error("Draft should have been removed")
+	}
+	if len(allowed) != 3 {
+		t.Errorf("set should have 3 values, got %d", len(allowed))
+	}
+}
+
+func TestStatusSetSlice(t *testing.T) {
+	// Values come out in StatusValues order, whatever order they were added in
+	s := NewStatusSet(Archived, Draft, Review)
+	if got, want := s.Slice(), []Status{Draft, Review, Archived}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Slice() should be %v, got %v", want, got)
+	}
+
+	// Values that aren't valid can be held, but aren't listed
+	s.Add(Status(3))
+	if !s.Contains(Status(3)) {
+		t.Error("set should contain Status(3)")
+	}
+	if got := len(s.Slice()); got != 3 {
+		t.Errorf("Slice() should leave out Status(3), got %v", s.Slice())
+	}
+
+	var empty StatusSet
+	if empty.Contains(Draft) || len(empty.Slice()) != 0 {
+		t.Error("a nil set should be empty")
+	}
+}
@@ -3,6 +3,7 @@ package testpkg
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"errors"
 	"testing"
 
 	"gopkg.in/yaml.v3"
@@ -51,6 +52,19 @@ func TestStatusParse(t *testing.T) {
 	}
 }
 
+func TestStatusMustString(t *testing.T) {
+	if s := MustStatusString("Running"); s != Running {
+		t.Errorf("Expected Running, got %v", s)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for invalid string")
+		}
+	}()
+	MustStatusString("Invalid")
+}
+
 func TestStatusValues_All(t *testing.T) {
 	values := StatusValues()
 	if len(values) != 4 {
@@ -61,6 +75,32 @@ func TestStatusValues_All(t *testing.T) {
 	}
 }
 
+func TestStatusNames(t *testing.T) {
+	names := StatusNames()
+	expected := []string{"Pending", "Running", "Success", "Failure"}
+	if len(names) != len(expected) {
+		t.Fatalf("Expected %d names, got %d", len(expected), len(names))
+	}
+	for i, v := range StatusValues() {
+		if names[i] != expected[i] || names[i] != v.String() {
+			t.Errorf("Name %d: expected %q, got %q", i, v.String(), names[i])
+		}
+	}
+}
+
+func TestStatusEntries(t *testing.T) {
+	entries := StatusEntries()
+	values := StatusValues()
+	if len(entries) != len(values) {
+		t.Fatalf("Expected %d entries, got %d", len(values), len(entries))
+	}
+	for i, e := range entries {
+		if e.Value != values[i] || e.Name != e.Value.String() {
+			t.Errorf("Entry %d: expected {%q %d}, got {%q %d}", i, values[i].String(), values[i], e.Name, e.Value)
+		}
+	}
+}
+
 func TestStatusValid(t *testing.T) {
 	if !Pending.Valid() {
 		t.Error("Pending should be valid")
@@ -94,6 +134,54 @@ func TestStatusJSON(t *testing.T) {
 	}
 }
 
+func TestStatusMarshalJSONMatchesString(t *testing.T) {
+	for _, s := range append(StatusValues(), Status(99)) {
+		want, err := json.Marshal(s.String())
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		got, err := s.MarshalJSON()
+		if err != nil {
+			t.Fatalf("MarshalJSON failed: %v", err)
+		}
+		if string(got) != string(want) {
+			t.Errorf("%v.MarshalJSON() should be %s, got %s", s, want, got)
+		}
+	}
+}
+
+func BenchmarkStatusMarshalJSON(b *testing.B) {
+	statuses := make([]Status, 1000)
+	for i := range statuses {
+		statuses[i] = Status(i % 4)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, s := range statuses {
+			if _, err := s.MarshalJSON(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// BenchmarkStatusMarshalJSONString marshals the string as MarshalJSON used
+// to, for comparison with BenchmarkStatusMarshalJSON
+func BenchmarkStatusMarshalJSONString(b *testing.B) {
+	statuses := make([]Status, 1000)
+	for i := range statuses {
+		statuses[i] = Status(i % 4)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, s := range statuses {
+			if _, err := json.Marshal(s.String()); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
 func TestStatusYAML(t *testing.T) {
 	// Marshal
 	data, err := yaml.Marshal(Running)
@@ -177,3 +265,66 @@ func TestStatusSQL(t *testing.T) {
 		t.Errorf("Expected \"Success\", got %v", val)
 	}
 }
+
+func TestStatusInvalidErrors(t *testing.T) {
+	// All parse paths wrap ErrInvalidStatus
+	_, err := StatusString("Unknown")
+	if !errors.Is(err, ErrInvalidStatus) {
+		t.Errorf("StatusString error should wrap ErrInvalidStatus, got %v", err)
+	}
+	if err.Error() != "Unknown is not a valid Status" {
+		t.Errorf("unexpected StatusString error: %v", err)
+	}
+
+	_, err = StatusFromValue(42)
+	if !errors.Is(err, ErrInvalidStatus) {
+		t.Errorf("StatusFromValue error should wrap ErrInvalidStatus, got %v", err)
+	}
+
+	var s Status
+	for _, data := range []string{`"Unknown"`, `42`} {
+		if err := json.Unmarshal([]byte(data), &s); !errors.Is(err, ErrInvalidStatus) {
+			t.Errorf("UnmarshalJSON(%s) error should wrap ErrInvalidStatus, got %v", data, err)
+		}
+	}
+
+	for _, data := range []string{"Unknown", "[1, 2]"} {
+		if err := yaml.Unmarshal([]byte(data), &s); !errors.Is(err, ErrInvalidStatus) {
+			t.Errorf("UnmarshalYAML(%s) error should wrap ErrInvalidStatus, got %v", data, err)
+		}
+	}
+
+	for _, value := range []any{"Unknown", []byte("Unknown"), 42} {
+		if err := s.Scan(value); !errors.Is(err, ErrInvalidStatus) {
+			t.Errorf("Scan(%#v) error should wrap ErrInvalidStatus, got %v", value, err)
+		}
+	}
+}
+
+func TestStatusStringExactMatch(t *testing.T) {
+	// Without -trimspace, padded strings don't match
+	for _, input := range []string{" Success ", "Success\n"} {
+		if _, err := StatusString(input); err == nil {
+			t.Errorf("StatusString(%q) should fail", input)
+		}
+	}
+}
+
+func TestIsValidStatusName(t *testing.T) {
+	if !IsValidStatusName("Success") {
+		t.Error("IsValidStatusName(Success) should be true")
+	}
+	for _, input := range []string{"Unknown", "success", " Success", ""} {
+		if IsValidStatusName(input) {
+			t.Errorf("IsValidStatusName(%q) should be false", input)
+		}
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		IsValidStatusName("Success")
+		IsValidStatusName("Unknown")
+	})
+	if allocs != 0 {
+		t.Errorf("IsValidStatusName should not allocate, got %v allocations", allocs)
+	}
+}
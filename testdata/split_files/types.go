@@ -0,0 +1,19 @@
+package testpkg
+
+// Status represents a simple enum
+type Status int
+
+const (
+	Pending Status = iota
+	Running
+	Success
+)
+
+// Priority represents another enum generated into its own file
+type Priority int
+
+const (
+	Low Priority = iota
+	Medium
+	High
+)
@@ -0,0 +1,50 @@
+package testpkg
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestSplitFilesExist(t *testing.T) {
+	for _, name := range []string{"status_enumer.go", "priority_enumer.go"} {
+		if _, err := os.Stat(name); err != nil {
+			t.Errorf("Expected %s to be generated: %v", name, err)
+		}
+	}
+	if _, err := os.Stat("enums_gen.go"); err == nil {
+		t.Error("Combined enums_gen.go should not be generated")
+	}
+}
+
+func TestSplitFilesContents(t *testing.T) {
+	data, err := os.ReadFile("priority_enumer.go")
+	if err != nil {
+		t.Fatalf("Failed to read priority_enumer.go: %v", err)
+	}
+	content := string(data)
+
+	if !strings.Contains(content, "func PriorityString(") {
+		t.Error("priority_enumer.go should contain PriorityString")
+	}
+	if strings.Contains(content, "func StatusString(") {
+		t.Error("priority_enumer.go should not contain StatusString")
+	}
+	if !strings.Contains(content, "// Command: enumer -type=Priority -json -splitfiles\n") {
+		t.Error("priority_enumer.go should carry its own command")
+	}
+}
+
+func TestSplitFilesMethods(t *testing.T) {
+	data, err := json.Marshal(struct {
+		S Status
+		P Priority
+	}{Running, High})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != `{"S":"Running","P":"High"}` {
+		t.Errorf("Unexpected JSON: %s", data)
+	}
+}
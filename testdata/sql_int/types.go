@@ -0,0 +1,18 @@
+package testpkg
+
+// Status is stored in an INTEGER column
+type Status int
+
+const (
+	Pending Status = iota + 1
+	Active
+	Closed
+)
+
+// Level checks scanning into a narrower type
+type Level uint8
+
+const (
+	Low  Level = 10
+	High Level = 200
+)
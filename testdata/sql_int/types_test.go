@@ -0,0 +1,104 @@
+package testpkg
+
+import (
+	"database/sql/driver"
+	"errors"
+	"testing"
+)
+
+func TestStatusValue(t *testing.T) {
+	v, err := Active.Value()
+	if err != nil {
+		t.Fatalf("Value failed: %v", err)
+	}
+	if v != int64(2) {
+		t.Errorf("Value should be int64(2), got %#v", v)
+	}
+	if !driver.IsValue(v) {
+		t.Errorf("Value should return a valid driver.Value, got %T", v)
+	}
+}
+
+func TestStatusScan(t *testing.T) {
+	tests := []struct {
+		input    any
+		expected Status
+	}{
+		{int64(1), Pending},
+		{2, Active},
+		{[]byte("3"), Closed},
+		{"2", Active},
+	}
+
+	for _, tt := range tests {
+		var s Status
+		if err := s.Scan(tt.input); err != nil {
+			t.Errorf("Scan(%#v) failed: %v", tt.input, err)
+			continue
+		}
+		if s != tt.expected {
+			t.Errorf("Scan(%#v) should produce %v, got %v", tt.input, tt.expected, s)
+		}
+	}
+}
+
+func TestStatusScanNil(t *testing.T) {
+	s := Active
+	if err := s.Scan(nil); err != nil {
+		t.Errorf("Scan(nil) failed: %v", err)
+	}
+	if s != Active {
+		t.Errorf("Scan(nil) should leave the value unchanged, got %v", s)
+	}
+}
+
+func TestStatusScanInvalid(t *testing.T) {
+	invalid := []any{
+		int64(0),
+		int64(99),
+		[]byte("Active"),
+		"",
+		1.5,
+		true,
+	}
+
+	for _, input := range invalid {
+		var s Status
+		if err := s.Scan(input); !errors.Is(err, ErrInvalidStatus) {
+			t.Errorf("Scan(%#v) should fail with ErrInvalidStatus, got %v, %v", input, s, err)
+		}
+	}
+}
+
+func TestLevelScanOutOfRange(t *testing.T) {
+	var l Level
+	if err := l.Scan(int64(200)); err != nil || l != High {
+		t.Errorf("Scan(200) should produce High, got %v, %v", l, err)
+	}
+
+	// 266 would wrap around to 10 (Low) if truncated
+	l = 0
+	if err := l.Scan(int64(266)); err == nil {
+		t.Errorf("Scan(266) should fail, got %v", l)
+	}
+	if err := l.Scan(int64(-246)); err == nil {
+		t.Errorf("Scan(-246) should fail, got %v", l)
+	}
+}
+
+func TestSQLRoundTrip(t *testing.T) {
+	for _, s := range StatusValues() {
+		v, err := s.Value()
+		if err != nil {
+			t.Fatalf("Value(%v) failed: %v", s, err)
+		}
+
+		var got Status
+		if err := got.Scan(v); err != nil {
+			t.Fatalf("Scan(%#v) failed: %v", v, err)
+		}
+		if got != s {
+			t.Errorf("round trip of %v produced %v", s, got)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package testpkg
+
+// Status is stored as text, but may be read back from an integer column
+type Status int
+
+const (
+	Pending Status = iota
+	Active
+	Closed
+)
@@ -0,0 +1,54 @@
+package testpkg
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestStatusScanInt(t *testing.T) {
+	var s Status
+	if err := s.Scan(int64(2)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if s != Closed {
+		t.Errorf("Expected Closed, got %v", s)
+	}
+
+	if err := s.Scan(1); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if s != Active {
+		t.Errorf("Expected Active, got %v", s)
+	}
+}
+
+func TestStatusScanIntInvalid(t *testing.T) {
+	s := Active
+	for _, value := range []any{int64(3), int64(-1), int64(1) << 40} {
+		if err := s.Scan(value); !errors.Is(err, ErrInvalidStatus) {
+			t.Errorf("Expected ErrInvalidStatus scanning %v, got %v", value, err)
+		}
+	}
+}
+
+func TestStatusScanString(t *testing.T) {
+	var s Status
+	if err := s.Scan([]byte("Closed")); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if s != Closed {
+		t.Errorf("Expected Closed, got %v", s)
+	}
+}
+
+func TestStatusScanErrorValue(t *testing.T) {
+	s := Active
+	err := s.Scan(int64(42))
+	if err == nil || !strings.Contains(err.Error(), "42") {
+		t.Errorf("Expected error mentioning 42, got %v", err)
+	}
+	if s != Active {
+		t.Errorf("A failed Scan should leave the value unchanged, got %v", s)
+	}
+}
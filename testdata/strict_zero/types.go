@@ -0,0 +1,10 @@
+package testpkg
+
+// Status starts at 1, leaving the zero value to mean unset
+type Status int
+
+const (
+	Pending Status = iota + 1
+	Active
+	Closed
+)
@@ -0,0 +1,62 @@
+package testpkg
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestStatusZeroInvalid(t *testing.T) {
+	var s Status
+	if s.Valid() {
+		t.Error("The zero value should not be valid")
+	}
+	if _, err := StatusFromValue(0); !errors.Is(err, ErrInvalidStatus) {
+		t.Errorf("Expected ErrInvalidStatus, got %v", err)
+	}
+}
+
+func TestStatusErrorsReturnZero(t *testing.T) {
+	if s, err := StatusString("Bogus"); err == nil || s != 0 {
+		t.Errorf("Expected the zero value and an error, got %v, %v", s, err)
+	}
+	if s, err := StatusFromValue(7); err == nil || s != 0 {
+		t.Errorf("Expected the zero value and an error, got %v, %v", s, err)
+	}
+}
+
+func TestStatusDecodeErrorsStayUnset(t *testing.T) {
+	var m struct {
+		Status Status `json:"status"`
+	}
+	if err := json.Unmarshal([]byte(`{"status":"Bogus"}`), &m); !errors.Is(err, ErrInvalidStatus) {
+		t.Errorf("Expected ErrInvalidStatus, got %v", err)
+	}
+	if m.Status != 0 || m.Status.Valid() {
+		t.Errorf("A failed decode should leave the field unset, got %v", m.Status)
+	}
+
+	var s Status
+	if err := s.UnmarshalText([]byte("Bogus")); err == nil {
+		t.Error("UnmarshalText should fail")
+	}
+	if err := s.Scan("Bogus"); err == nil {
+		t.Error("Scan should fail")
+	}
+	if s != 0 {
+		t.Errorf("Failed decodes should leave the value unset, got %v", s)
+	}
+}
+
+func TestStatusDecodeErrorKeepsValue(t *testing.T) {
+	s := Active
+	if err := s.UnmarshalJSON([]byte(`"Bogus"`)); err == nil {
+		t.Error("UnmarshalJSON should fail")
+	}
+	if err := s.UnmarshalText([]byte("Bogus")); err == nil {
+		t.Error("UnmarshalText should fail")
+	}
+	if s != Active {
+		t.Errorf("Failed decodes should leave the value unchanged, got %v", s)
+	}
+}
@@ -0,0 +1,9 @@
+package testpkg
+
+// Status declares a zero constant, so -strictzero can't be used
+type Status int
+
+const (
+	Unset Status = iota
+	Active
+)
@@ -0,0 +1,10 @@
+package testpkg
+
+// Currency represents a string based enum
+type Currency string
+
+const (
+	USD Currency = "usd"
+	EUR Currency = "eur"
+	GBP Currency = "gbp"
+)
@@ -0,0 +1,104 @@
+package testpkg
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+)
+
+func TestCurrencyString(t *testing.T) {
+	if USD.String() != "usd" {
+		t.Errorf("Expected 'usd', got %q", USD.String())
+	}
+	if Currency("xyz").String() != "xyz" {
+		t.Errorf("Expected 'xyz', got %q", Currency("xyz").String())
+	}
+}
+
+func TestCurrencyParse(t *testing.T) {
+	c, err := CurrencyString("eur")
+	if err != nil {
+		t.Fatalf("Failed to parse 'eur': %v", err)
+	}
+	if c != EUR {
+		t.Errorf("Expected EUR, got %v", c)
+	}
+
+	c, err = CurrencyString("EUR")
+	if err == nil {
+		t.Error("Expected error parsing constant name 'EUR'")
+	}
+	if c != "" {
+		t.Errorf("Expected empty value on error, got %q", c)
+	}
+}
+
+func TestCurrencyFromValue(t *testing.T) {
+	c, err := CurrencyFromValue("gbp")
+	if err != nil || c != GBP {
+		t.Errorf("Expected GBP, got %v, %v", c, err)
+	}
+	c, err = CurrencyFromValue("xyz")
+	if err == nil {
+		t.Error("Expected error for unknown value")
+	}
+	if c != "" {
+		t.Errorf("Expected empty value on error, got %q", c)
+	}
+}
+
+func TestCurrencyValid(t *testing.T) {
+	if !USD.Valid() {
+		t.Error("USD should be valid")
+	}
+	if Currency("xyz").Valid() {
+		t.Error("Currency(xyz) should not be valid")
+	}
+}
+
+func TestCurrencyValues(t *testing.T) {
+	values := CurrencyValues()
+	if len(values) != 3 {
+		t.Fatalf("Expected 3 values, got %d", len(values))
+	}
+}
+
+func TestCurrencyJSON(t *testing.T) {
+	data, err := json.Marshal(GBP)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != `"gbp"` {
+		t.Errorf("Expected \"gbp\", got %s", data)
+	}
+
+	var c Currency
+	if err := json.Unmarshal([]byte(`"usd"`), &c); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if c != USD {
+		t.Errorf("Expected USD, got %v", c)
+	}
+
+	if err := json.Unmarshal([]byte(`"xyz"`), &c); err == nil {
+		t.Error("Expected error for invalid currency")
+	}
+}
+
+func TestCurrencySQL(t *testing.T) {
+	var c Currency
+	if err := c.Scan([]byte("eur")); err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+	if c != EUR {
+		t.Errorf("Expected EUR, got %v", c)
+	}
+
+	val, err := USD.Value()
+	if err != nil {
+		t.Fatalf("Value failed: %v", err)
+	}
+	if val != driver.Value("usd") {
+		t.Errorf("Expected \"usd\", got %v", val)
+	}
+}
@@ -0,0 +1,10 @@
+package testpkg
+
+// Kind is a string enum whose line comments are ignored
+type Kind string
+
+const (
+	A Kind = "a" // x, y
+	B Kind = "b" // 100%d
+	C Kind = "c"
+)
@@ -0,0 +1,31 @@
+package testpkg
+
+import "testing"
+
+func TestKindIgnoresLineComment(t *testing.T) {
+	tests := []struct {
+		value    Kind
+		expected string
+	}{
+		{A, "a"},
+		{B, "b"},
+		{C, "c"},
+	}
+
+	for _, tt := range tests {
+		if s := tt.value.String(); s != tt.expected {
+			t.Errorf("Expected %q, got %q", tt.expected, s)
+		}
+		k, err := KindString(tt.expected)
+		if err != nil || k != tt.value {
+			t.Errorf("KindString(%q) = %v, %v, expected %v", tt.expected, k, err, tt.value)
+		}
+	}
+
+	// No name in a comment parses
+	for _, name := range []string{"x", "y", "100%d"} {
+		if _, err := KindString(name); err == nil {
+			t.Errorf("Expected error parsing comment name %q", name)
+		}
+	}
+}
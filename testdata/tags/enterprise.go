@@ -0,0 +1,8 @@
+//go:build enterprise
+
+package testpkg
+
+// Enterprise builds add an archived status
+const (
+	Archived Status = 10
+)
@@ -0,0 +1,10 @@
+package testpkg
+
+// Status has further constants in a file behind a build tag
+type Status int
+
+const (
+	Pending Status = iota
+	Active
+	Closed
+)
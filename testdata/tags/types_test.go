@@ -0,0 +1,15 @@
+package testpkg
+
+import "testing"
+
+func TestTaggedConstantIncluded(t *testing.T) {
+	if len(StatusValues()) != 4 {
+		t.Fatalf("Expected 4 values, got %v", StatusValues())
+	}
+	if s := Archived.String(); s != "Archived" {
+		t.Errorf("Expected Archived, got %q", s)
+	}
+	if v, err := StatusString("Archived"); err != nil || v != Archived {
+		t.Errorf("Expected Archived, got %v, %v", v, err)
+	}
+}
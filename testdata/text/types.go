@@ -0,0 +1,11 @@
+package testpkg
+
+// Status represents an enum with text marshaling
+type Status int
+
+const (
+	Pending Status = iota
+	Running
+	Success
+	Failure
+)
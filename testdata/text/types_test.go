@@ -0,0 +1,58 @@
+package testpkg
+
+import (
+	"encoding"
+	"encoding/json"
+	"testing"
+)
+
+var (
+	_ encoding.TextMarshaler   = Status(0)
+	_ encoding.TextUnmarshaler = (*Status)(nil)
+)
+
+func TestStatusText(t *testing.T) {
+	text, err := Running.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText failed: %v", err)
+	}
+	if string(text) != "Running" {
+		t.Errorf("Expected 'Running', got %q", text)
+	}
+
+	var s Status
+	if err := s.UnmarshalText([]byte("Failure")); err != nil {
+		t.Fatalf("UnmarshalText failed: %v", err)
+	}
+	if s != Failure {
+		t.Errorf("Expected Failure, got %v", s)
+	}
+
+	if err := s.UnmarshalText([]byte("Invalid")); err == nil {
+		t.Error("Expected error for invalid text")
+	}
+}
+
+func TestStatusJSONMapKey(t *testing.T) {
+	counts := map[Status]int{Pending: 1, Success: 3}
+
+	data, err := json.Marshal(counts)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != `{"Pending":1,"Success":3}` {
+		t.Errorf("Unexpected JSON: %s", data)
+	}
+
+	var decoded map[Status]int
+	if err := json.Unmarshal([]byte(`{"Running":2,"Failure":4}`), &decoded); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if decoded[Running] != 2 || decoded[Failure] != 4 {
+		t.Errorf("Unexpected map: %v", decoded)
+	}
+
+	if err := json.Unmarshal([]byte(`{"Invalid":1}`), &decoded); err == nil {
+		t.Error("Expected error for invalid map key")
+	}
+}
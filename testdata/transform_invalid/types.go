@@ -0,0 +1,9 @@
+package testpkg
+
+// Event represents an enum generated with an unknown transform
+type Event int
+
+const (
+	UserSignedUp Event = iota
+	OrderShipped
+)
@@ -0,0 +1,10 @@
+package testpkg
+
+// Event represents an enum whose names are transformed to pascal case
+type Event int
+
+const (
+	EventUserSignedUp Event = iota
+	EventHTTPRequestFailed
+	EventOrderShipped
+)
@@ -0,0 +1,42 @@
+package testpkg
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEventTransform(t *testing.T) {
+	tests := []struct {
+		value    Event
+		expected string
+	}{
+		{EventUserSignedUp, "user_signed_up"},
+		{EventHTTPRequestFailed, "http_request_failed"},
+		{EventOrderShipped, "order_shipped"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.value.String(); got != tt.expected {
+			t.Errorf("Expected %q, got %q", tt.expected, got)
+		}
+
+		parsed, err := EventString(tt.expected)
+		if err != nil {
+			t.Errorf("Failed to parse %q: %v", tt.expected, err)
+			continue
+		}
+		if parsed != tt.value {
+			t.Errorf("Parsing %q: expected %v, got %v", tt.expected, tt.value, parsed)
+		}
+	}
+}
+
+func TestEventTransformJSON(t *testing.T) {
+	data, err := json.Marshal(EventOrderShipped)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != `"order_shipped"` {
+		t.Errorf("Expected \"order_shipped\", got %s", data)
+	}
+}
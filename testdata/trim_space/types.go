@@ -0,0 +1,10 @@
+package testpkg
+
+// Status is read from CSV cells and query parameters
+type Status int
+
+const (
+	Pending Status = iota
+	Running
+	Success
+)
@@ -0,0 +1,44 @@
+package testpkg
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestStatusStringTrimsSpace(t *testing.T) {
+	for _, input := range []string{" Success ", "Success\n", "\tSuccess", "Success"} {
+		got, err := StatusString(input)
+		if err != nil {
+			t.Errorf("StatusString(%q) failed: %v", input, err)
+			continue
+		}
+		if got != Success {
+			t.Errorf("StatusString(%q) should produce Success, got %v", input, got)
+		}
+	}
+
+	for _, input := range []string{"", "   ", "Suc cess"} {
+		if _, err := StatusString(input); err == nil {
+			t.Errorf("StatusString(%q) should fail", input)
+		}
+	}
+}
+
+func TestStatusDecodersTrimSpace(t *testing.T) {
+	var s Status
+	if err := json.Unmarshal([]byte(`" Running "`), &s); err != nil || s != Running {
+		t.Errorf("UnmarshalJSON should trim spaces, got %v, %v", s, err)
+	}
+
+	s = Pending
+	if err := yaml.Unmarshal([]byte(`" Success "`), &s); err != nil || s != Success {
+		t.Errorf("UnmarshalYAML should trim spaces, got %v, %v", s, err)
+	}
+
+	s = Pending
+	if err := s.Scan([]byte("Running  ")); err != nil || s != Running {
+		t.Errorf("Scan should trim spaces, got %v, %v", s, err)
+	}
+}
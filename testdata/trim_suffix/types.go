@@ -0,0 +1,11 @@
+package testpkg
+
+// Status represents an enum with a common prefix and suffix
+type Status int
+
+const (
+	StatusActiveState Status = iota
+	StatusClosedState
+	StatusOnHoldState // paused
+	StatusArchived
+)
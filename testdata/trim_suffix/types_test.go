@@ -0,0 +1,38 @@
+package testpkg
+
+import "testing"
+
+func TestStatusTrimSuffix(t *testing.T) {
+	tests := []struct {
+		value    Status
+		expected string
+	}{
+		{StatusActiveState, "Active"},
+		{StatusClosedState, "Closed"},
+		{StatusOnHoldState, "paused"}, // line comment wins
+		{StatusArchived, "Archived"},  // no suffix to trim
+	}
+
+	for _, tt := range tests {
+		if got := tt.value.String(); got != tt.expected {
+			t.Errorf("Expected %q, got %q", tt.expected, got)
+		}
+
+		parsed, err := StatusString(tt.expected)
+		if err != nil {
+			t.Errorf("Failed to parse %q: %v", tt.expected, err)
+			continue
+		}
+		if parsed != tt.value {
+			t.Errorf("Parsing %q: expected %v, got %v", tt.expected, tt.value, parsed)
+		}
+	}
+}
+
+func TestStatusTrimSuffixFullName(t *testing.T) {
+	for _, name := range []string{"StatusActiveState", "ActiveState", "StatusActive"} {
+		if _, err := StatusString(name); err == nil {
+			t.Errorf("Should not be able to parse %q", name)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package testpkg
+
+// Status represents an enum with constants declared through an alias
+type Status int
+
+// State is an alias of Status, so its constants are Status constants
+type State = Status
+
+// Phase is a distinct type defined from Status, so its constants aren't
+type Phase Status
+
+const (
+	Pending Status = iota
+	Running State  = 1
+	Done    State  = 2
+
+	Planning Phase = 0
+	Building Phase = 3
+)
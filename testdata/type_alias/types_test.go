@@ -0,0 +1,29 @@
+package testpkg
+
+import "testing"
+
+func TestStatusAliasConstants(t *testing.T) {
+	expected := []Status{Pending, Running, Done}
+	values := StatusValues()
+	if len(values) != len(expected) {
+		t.Fatalf("Expected %d values, got %d: %v", len(expected), len(values), values)
+	}
+	for i := range expected {
+		if values[i] != expected[i] {
+			t.Errorf("Index %d: expected %v, got %v", i, expected[i], values[i])
+		}
+	}
+	if Running.String() != "Running" {
+		t.Errorf("Expected Running, got %q", Running.String())
+	}
+}
+
+func TestStatusDerivedTypeExcluded(t *testing.T) {
+	// Building shares the underlying kind, but is a different type
+	if _, err := StatusString("Building"); err == nil {
+		t.Error("Expected Building not to parse as a Status")
+	}
+	if Status(Building).Valid() {
+		t.Error("Expected the value of Building not to be a valid Status")
+	}
+}
@@ -0,0 +1,19 @@
+package testpkg
+
+// Code represents an enum with an unsigned 64 bit underlying type
+type Code uint64
+
+const (
+	CodeNone  Code = 0
+	CodeSmall Code = 1
+	CodeLarge Code = 1 << 63
+)
+
+// Offset represents an enum with a signed 8 bit underlying type
+type Offset int8
+
+const (
+	Min  Offset = -128
+	Zero Offset = 0
+	Max  Offset = 127
+)
@@ -0,0 +1,78 @@
+package testpkg
+
+import "testing"
+
+func TestCodeString(t *testing.T) {
+	if CodeLarge.String() != "CodeLarge" {
+		t.Errorf("Expected 'CodeLarge', got %q", CodeLarge.String())
+	}
+
+	// Large unknown values must not format as negative
+	unknown := Code(1<<64 - 1)
+	if unknown.String() != "Code(18446744073709551615)" {
+		t.Errorf("Unexpected string for unknown value: %s", unknown.String())
+	}
+}
+
+func TestCodeFromValue(t *testing.T) {
+	c, err := CodeFromValue(1 << 63)
+	if err != nil || c != CodeLarge {
+		t.Errorf("Expected CodeLarge, got %v, %v", c, err)
+	}
+	if _, err := CodeFromValue(2); err == nil {
+		t.Error("Expected error for value 2")
+	}
+}
+
+func TestCodeParseNumber(t *testing.T) {
+	c, err := CodeString("9223372036854775808")
+	if err != nil || c != CodeLarge {
+		t.Errorf("Expected CodeLarge, got %v, %v", c, err)
+	}
+	if _, err := CodeString("-1"); err == nil {
+		t.Error("Expected error parsing '-1'")
+	}
+}
+
+func TestOffsetString(t *testing.T) {
+	if Min.String() != "Min" {
+		t.Errorf("Expected 'Min', got %q", Min.String())
+	}
+
+	unknown := Offset(-5)
+	if unknown.String() != "Offset(-5)" {
+		t.Errorf("Unexpected string for unknown value: %s", unknown.String())
+	}
+}
+
+func TestOffsetFromValue(t *testing.T) {
+	o, err := OffsetFromValue(-128)
+	if err != nil || o != Min {
+		t.Errorf("Expected Min, got %v, %v", o, err)
+	}
+	if _, err := OffsetFromValue(-1); err == nil {
+		t.Error("Expected error for value -1")
+	}
+}
+
+func TestOffsetParseNumber(t *testing.T) {
+	o, err := OffsetString("-128")
+	if err != nil || o != Min {
+		t.Errorf("Expected Min, got %v, %v", o, err)
+	}
+
+	// 384 would truncate to -128 as an int8, so must be out of range
+	if _, err := OffsetString("384"); err == nil {
+		t.Error("Expected error parsing '384'")
+	}
+}
+
+func TestBounds(t *testing.T) {
+	// Bounds come from the declared values, including negatives
+	if OffsetMin() != Min || OffsetMax() != Max || OffsetLen() != 3 {
+		t.Errorf("Offset bounds should be Min, Max, 3, got %v, %v, %d", OffsetMin(), OffsetMax(), OffsetLen())
+	}
+	if CodeMin() != CodeNone || CodeMax() != CodeLarge || CodeLen() != 3 {
+		t.Errorf("Code bounds should be CodeNone, CodeLarge, 3, got %v, %v, %d", CodeMin(), CodeMax(), CodeLen())
+	}
+}
@@ -0,0 +1,18 @@
+package testpkg
+
+// Status is checked alongside other fields of a request
+type Status int
+
+const (
+	Pending Status = iota
+	Active
+	Closed
+)
+
+// Currency is a string based enum
+type Currency string
+
+const (
+	GBP Currency = "GBP"
+	USD Currency = "USD"
+)
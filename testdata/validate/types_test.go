@@ -0,0 +1,33 @@
+package testpkg
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateStatus(t *testing.T) {
+	if err := ValidateStatus(Pending, Closed, Active); err != nil {
+		t.Errorf("Expected all values to be valid, got %v", err)
+	}
+	if err := ValidateStatus(); err != nil {
+		t.Errorf("Expected no values to be valid, got %v", err)
+	}
+
+	err := ValidateStatus(Active, Status(7), Status(9))
+	if !errors.Is(err, ErrInvalidStatus) {
+		t.Fatalf("Expected ErrInvalidStatus, got %v", err)
+	}
+	if err.Error() != "7 is not a valid Status" {
+		t.Errorf("Expected the first invalid value to be named, got %q", err)
+	}
+}
+
+func TestValidateCurrency(t *testing.T) {
+	if err := ValidateCurrency(GBP, USD); err != nil {
+		t.Errorf("Expected all values to be valid, got %v", err)
+	}
+	err := ValidateCurrency(GBP, "EUR")
+	if !errors.Is(err, ErrInvalidCurrency) || err.Error() != `"EUR" is not a valid Currency` {
+		t.Errorf("Expected EUR to be named, got %v", err)
+	}
+}
@@ -0,0 +1,10 @@
+package testpkg
+
+// Status falls back to a default when parsing unknown strings
+type Status int
+
+const (
+	Unknown Status = iota
+	Active
+	Closed
+)
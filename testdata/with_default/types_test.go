@@ -0,0 +1,23 @@
+package testpkg
+
+import "testing"
+
+func TestStatusOrDefault(t *testing.T) {
+	tests := []struct {
+		input    string
+		def      Status
+		expected Status
+	}{
+		{"Active", Unknown, Active},
+		{" Closed ", Unknown, Closed},
+		{"Missing", Unknown, Unknown},
+		{"Missing", Active, Active},
+		{"", Closed, Closed},
+	}
+
+	for _, tt := range tests {
+		if got := StatusOrDefault(tt.input, tt.def); got != tt.expected {
+			t.Errorf("StatusOrDefault(%q, %v) = %v, expected %v", tt.input, tt.def, got, tt.expected)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package testpkg
 
-import "testing"
+import (
+	"fmt"
+	"testing"
+)
 
 func TestPriorityValues(t *testing.T) {
 	// Test exact numeric values with gaps
@@ -37,3 +40,125 @@ func TestPriorityInvalid(t *testing.T) {
 		t.Errorf("Invalid value string incorrect: %s", invalid.String())
 	}
 }
+
+func TestPriorityFromValue(t *testing.T) {
+	p, err := PriorityFromValue(5)
+	if err != nil {
+		t.Fatalf("Failed to get value 5: %v", err)
+	}
+	if p != Medium {
+		t.Errorf("Expected Medium, got %v", p)
+	}
+
+	_, err = PriorityFromValue(7)
+	if err == nil {
+		t.Error("Expected error for value 7")
+	}
+}
+
+func TestPriorityNavigation(t *testing.T) {
+	next, ok := Medium.Next()
+	if !ok || next != High {
+		t.Errorf("Medium.Next() should be High, got %v, %v", next, ok)
+	}
+	prev, ok := Medium.Prev()
+	if !ok || prev != Low {
+		t.Errorf("Medium.Prev() should be Low, got %v, %v", prev, ok)
+	}
+
+	// The ends have no neighbor
+	if _, ok := Urgent.Next(); ok {
+		t.Error("Urgent.Next() should not be ok")
+	}
+	if _, ok := Low.Prev(); ok {
+		t.Error("Low.Prev() should not be ok")
+	}
+
+	// Values between the constants aren't part of the sequence
+	if _, ok := Priority(6).Next(); ok {
+		t.Error("Priority(6).Next() should not be ok")
+	}
+	if _, ok := Priority(6).Prev(); ok {
+		t.Error("Priority(6).Prev() should not be ok")
+	}
+}
+
+func TestPriorityNavigationWalk(t *testing.T) {
+	var walked []Priority
+	for p, ok := Low, true; ok; p, ok = p.Next() {
+		walked = append(walked, p)
+	}
+
+	values := PriorityValues()
+	if len(walked) != len(values) {
+		t.Fatalf("walking with Next should visit %v, got %v", values, walked)
+	}
+	for i := range values {
+		if walked[i] != values[i] {
+			t.Errorf("step %d should be %v, got %v", i, values[i], walked[i])
+		}
+	}
+}
+
+func TestPriorityBounds(t *testing.T) {
+	if PriorityMin() != Low {
+		t.Errorf("PriorityMin() should be Low, got %v", PriorityMin())
+	}
+	if PriorityMax() != Urgent {
+		t.Errorf("PriorityMax() should be Urgent, got %v", PriorityMax())
+	}
+	if PriorityLen() != 4 {
+		t.Errorf("PriorityLen() should be 4, got %d", PriorityLen())
+	}
+}
+
+func TestPriorityAppendString(t *testing.T) {
+	tests := []struct {
+		p    Priority
+		want string
+	}{
+		{High, "High"},
+		{Priority(3), "Priority(3)"},
+		{Priority(-7), "Priority(-7)"},
+	}
+	for _, tt := range tests {
+		if got := string(tt.p.AppendString([]byte("p="))); got != "p="+tt.want {
+			t.Errorf("AppendString should give %q, got %q", "p="+tt.want, got)
+		}
+		if got := tt.p.String(); got != tt.want {
+			t.Errorf("String() should be %q, got %q", tt.want, got)
+		}
+	}
+
+	buf := make([]byte, 0, 64)
+	if n := testing.AllocsPerRun(100, func() { buf = Priority(3).AppendString(buf[:0]) }); n != 0 {
+		t.Errorf("AppendString into a large enough buffer should not allocate, got %v allocations", n)
+	}
+	if n := testing.AllocsPerRun(100, func() { _ = High.String() }); n != 0 {
+		t.Errorf("String() of a named value should not allocate, got %v allocations", n)
+	}
+}
+
+func BenchmarkPriorityStringUnknown(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = Priority(i % 100).String()
+	}
+}
+
+// BenchmarkPriorityStringUnknownSprintf formats unknown values as String
+// used to, for comparison with BenchmarkPriorityStringUnknown
+func BenchmarkPriorityStringUnknownSprintf(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = fmt.Sprintf("Priority(%d)", i%100)
+	}
+}
+
+func BenchmarkPriorityAppendString(b *testing.B) {
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = Priority(i % 100).AppendString(buf[:0])
+	}
+}
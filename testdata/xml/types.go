@@ -0,0 +1,18 @@
+package testpkg
+
+// Status represents an enum with XML marshaling
+type Status int
+
+const (
+	Pending Status = iota
+	Running
+	Success
+	Failure
+)
+
+// Job holds the enum both as an attribute and as an element
+type Job struct {
+	Name    string `xml:"name"`
+	State   Status `xml:"state,attr"`
+	Current Status `xml:"current"`
+}
@@ -0,0 +1,60 @@
+package testpkg
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestStatusXMLMarshal(t *testing.T) {
+	job := Job{Name: "build", State: Running, Current: Success}
+
+	data, err := xml.Marshal(job)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	expected := `<Job state="Running"><name>build</name><current>Success</current></Job>`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+}
+
+func TestStatusXMLUnmarshal(t *testing.T) {
+	var job Job
+	data := `<Job state="Failure"><name>deploy</name><current>Pending</current></Job>`
+	if err := xml.Unmarshal([]byte(data), &job); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if job.State != Failure {
+		t.Errorf("Expected State Failure, got %v", job.State)
+	}
+	if job.Current != Pending {
+		t.Errorf("Expected Current Pending, got %v", job.Current)
+	}
+}
+
+func TestStatusXMLRoundTrip(t *testing.T) {
+	for _, s := range StatusValues() {
+		in := Job{Name: "job", State: s, Current: s}
+		data, err := xml.Marshal(in)
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		var out Job
+		if err := xml.Unmarshal(data, &out); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		if out != in {
+			t.Errorf("Round trip mismatch: %+v != %+v", out, in)
+		}
+	}
+}
+
+func TestStatusXMLInvalid(t *testing.T) {
+	var job Job
+	if err := xml.Unmarshal([]byte(`<Job state="Bogus"></Job>`), &job); err == nil {
+		t.Error("Expected error for invalid attribute")
+	}
+	if err := xml.Unmarshal([]byte(`<Job><current>Bogus</current></Job>`), &job); err == nil {
+		t.Error("Expected error for invalid element")
+	}
+}
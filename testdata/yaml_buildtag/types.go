@@ -0,0 +1,10 @@
+package testpkg
+
+// Status represents an enum whose YAML methods are behind a build tag
+type Status int
+
+const (
+	Pending Status = iota
+	Running
+	Done
+)
@@ -0,0 +1,49 @@
+package testpkg
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestStatusBaseFileHasNoYAMLImport(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "status_enumer.go", nil, parser.ImportsOnly)
+	if err != nil {
+		t.Fatalf("Failed to parse the generated file: %v", err)
+	}
+	for _, imp := range file.Imports {
+		if imp.Path.Value == `"gopkg.in/yaml.v3"` {
+			t.Error("Expected status_enumer.go not to import gopkg.in/yaml.v3")
+		}
+	}
+}
+
+func TestStatusYAMLFileHasBuildTag(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "status_enumer_yaml.go", nil, parser.ParseComments|parser.ImportsOnly)
+	if err != nil {
+		t.Fatalf("Failed to parse the generated YAML file: %v", err)
+	}
+	if len(file.Comments) == 0 || file.Comments[0].List[0].Text != "//go:build yaml" {
+		t.Errorf("Expected status_enumer_yaml.go to start with //go:build yaml")
+	}
+}
+
+func TestStatusYAML(t *testing.T) {
+	data, err := yaml.Marshal(Running)
+	if err != nil {
+		t.Fatalf("Failed to marshal: %v", err)
+	}
+	if string(data) != "Running\n" {
+		t.Errorf("Expected \"Running\\n\", got %q", data)
+	}
+
+	var s Status
+	if err := yaml.Unmarshal([]byte("Done"), &s); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	if s != Done {
+		t.Errorf("Expected Done, got %v", s)
+	}
+}
@@ -0,0 +1,11 @@
+package testpkg
+
+// Level is a log level read from a yaml.v2 config file
+type Level int
+
+const (
+	Debug Level = iota
+	Info
+	Warn
+	Error
+)
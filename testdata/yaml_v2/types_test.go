@@ -0,0 +1,54 @@
+package testpkg
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+// The generated methods must satisfy the yaml.v2 interfaces
+var (
+	_ yaml.Marshaler   = Debug
+	_ yaml.Unmarshaler = (*Level)(nil)
+)
+
+type config struct {
+	Level Level `yaml:"level"`
+}
+
+func TestLevelYAMLMarshal(t *testing.T) {
+	data, err := yaml.Marshal(config{Level: Warn})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != "level: Warn\n" {
+		t.Errorf("Marshal should produce level: Warn, got %q", string(data))
+	}
+}
+
+func TestLevelYAMLUnmarshal(t *testing.T) {
+	var cfg config
+	if err := yaml.Unmarshal([]byte("level: Error\n"), &cfg); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if cfg.Level != Error {
+		t.Errorf("Unmarshal should produce Error, got %v", cfg.Level)
+	}
+}
+
+func TestLevelYAMLUnmarshalInvalid(t *testing.T) {
+	var cfg config
+	err := yaml.Unmarshal([]byte("level: Verbose\n"), &cfg)
+	if err == nil {
+		t.Fatal("Unmarshal should fail for an unknown level")
+	}
+	if !strings.Contains(err.Error(), "Verbose is not a valid Level") {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	err = yaml.Unmarshal([]byte("level: [1, 2]\n"), &cfg)
+	if err == nil {
+		t.Error("Unmarshal should fail for a non-string level")
+	}
+}
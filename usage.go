@@ -0,0 +1,91 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// usageGroups orders the flags in the usage message, grouping related
+// ones. Flags missing from every group are listed under "Other".
+var usageGroups = []struct {
+	title string
+	flags []string
+}{
+	{"Input", []string{"type", "tags", "gofile", "recursive"}},
+	{"Output", []string{"output", "filetemplate", "splitfiles", "manifest", "pkg", "buildtags", "comment", "idprefix", "template", "check", "version"}},
+	{"String representation", []string{"trimprefix", "trimsuffix", "addprefix", "transform", "linecomment", "invalidformat", "existing", "exclude"}},
+	{"Parsing", []string{"caseinsensitive", "parsenumber", "parseplaceholder", "trimspace", "parsemode", "strictzero", "batch", "normalize"}},
+	{"Encoding", []string{"json", "text", "binary", "xml", "yaml", "yamlversion", "sql", "nullable", "gql", "flag"}},
+	{"Other methods", []string{"bitmask", "iter", "navigation", "deprecation", "labels", "descriptions", "gostring", "docvalues", "withdefault", "validate", "csv", "set", "registry"}},
+}
+
+var usageExamples = []string{
+	"enumer -type=Status",
+	"enumer -type=Status -trimprefix=Status -json=number -sql",
+	"enumer -type=Color -linecomment -transform=snake -output=color_string.go ./model",
+}
+
+// usage prints the flags in groups, with the modes each mode flag accepts
+func usage() {
+	printUsage(flag.CommandLine.Output())
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintf(w, "Usage: enumer -type=T[,T...] [flags] [package]\n\n")
+	fmt.Fprintf(w, "The package defaults to the one in the current directory. Flags listing modes\n")
+	fmt.Fprintf(w, "take one after =, e.g. -json=number, as -json number reads number as the package.\n")
+	fmt.Fprintf(w, "\nExamples:\n")
+	for _, example := range usageExamples {
+		fmt.Fprintf(w, "  %s\n", example)
+	}
+
+	listed := make(map[string]bool)
+	for _, group := range usageGroups {
+		fmt.Fprintf(w, "\n%s:\n", group.title)
+		for _, name := range group.flags {
+			if f := flag.Lookup(name); f != nil {
+				printFlag(w, f)
+				listed[name] = true
+			}
+		}
+	}
+
+	var other []*flag.Flag
+	flag.VisitAll(func(f *flag.Flag) {
+		if !listed[f.Name] {
+			other = append(other, f)
+		}
+	})
+	if len(other) > 0 {
+		fmt.Fprintf(w, "\nOther:\n")
+		for _, f := range other {
+			printFlag(w, f)
+		}
+	}
+}
+
+// printFlag prints a flag as flag.PrintDefaults does, except that mode
+// flags show their modes
+func printFlag(w io.Writer, f *flag.Flag) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "  -%s", f.Name)
+	name, usage := flag.UnquoteUsage(f)
+	switch v := f.Value.(type) {
+	case *modeFlag:
+		fmt.Fprintf(&b, "[=%s]", strings.Join(v.modes, "|"))
+		usage += fmt.Sprintf("; given alone, it selects %s", v.def)
+	default:
+		if bf, ok := f.Value.(interface{ IsBoolFlag() bool }); ok && bf.IsBoolFlag() {
+			name = ""
+		}
+		if name != "" {
+			fmt.Fprintf(&b, " %s", name)
+		}
+		if f.DefValue != "" && f.DefValue != "false" {
+			usage += fmt.Sprintf(" (default %q)", f.DefValue)
+		}
+	}
+	fmt.Fprintf(w, "%s\n    \t%s\n", b.String(), strings.ReplaceAll(usage, "\n", "\n    \t"))
+}
@@ -0,0 +1,21 @@
+package main
+
+import "runtime/debug"
+
+// version is set when building releases, with
+// -ldflags "-X main.version=v1.2.3". Only a version set this way is
+// recorded in generated files, as the build info differs between builds
+// of the same source and would make -check fail
+var version string
+
+// enumerVersion returns the version of enumer printed by -version, falling
+// back to the module version go install records
+func enumerVersion() string {
+	if version != "" {
+		return version
+	}
+	if info, ok := debug.ReadBuildInfo(); ok && info.Main.Version != "" {
+		return info.Main.Version
+	}
+	return "(devel)"
+}